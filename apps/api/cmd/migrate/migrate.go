@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/google/uuid"
 	_ "github.com/joho/godotenv/autoload"
 	"gorm.io/gorm"
 )
@@ -54,6 +55,14 @@ func Migrate() error {
 		log.Println("✓ pgvector extension enabled")
 	}
 
+	// Enable pg_trgm extension (used by tag duplicate detection)
+	if err := gormDB.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+		log.Printf("⚠ pg_trgm extension not available: %v", err)
+		log.Println("⚠ Tag duplicate detection will fail until pg_trgm is installed")
+	} else {
+		log.Println("✓ pg_trgm extension enabled")
+	}
+
 	// AutoMigrate Video first (no special types)
 	if err := gormDB.AutoMigrate(&domain.Video{}); err != nil {
 		return fmt.Errorf("migration failed for Video: %w", err)
@@ -110,6 +119,42 @@ func Migrate() error {
 	}
 	log.Println("✓ TagAlias table migrated")
 
+	// Migrate TagAliasReviewLog (alias review queue audit)
+	if err := gormDB.AutoMigrate(&domain.TagAliasReviewLog{}); err != nil {
+		return fmt.Errorf("migration failed for TagAliasReviewLog: %w", err)
+	}
+	log.Println("✓ TagAliasReviewLog table migrated")
+
+	// Migrate TagDuplicateCandidate (duplicate detection job output)
+	if err := gormDB.AutoMigrate(&domain.TagDuplicateCandidate{}); err != nil {
+		return fmt.Errorf("migration failed for TagDuplicateCandidate: %w", err)
+	}
+	log.Println("✓ TagDuplicateCandidate table migrated")
+
+	// Migrate TagMergeLog + children (merge history for undo)
+	if err := gormDB.AutoMigrate(&domain.TagMergeLog{}, &domain.TagMergeLogAlias{}, &domain.TagMergeLogVideo{}, &domain.TagMergeLogChild{}, &domain.TagMergeLogSlug{}, &domain.TagMergeLogDisplayName{}); err != nil {
+		return fmt.Errorf("migration failed for TagMergeLog: %w", err)
+	}
+	log.Println("✓ TagMergeLog tables migrated")
+
+	// Migrate TagSlugHistory (old slugs kept after rename)
+	if err := gormDB.AutoMigrate(&domain.TagSlugHistory{}); err != nil {
+		return fmt.Errorf("migration failed for TagSlugHistory: %w", err)
+	}
+	log.Println("✓ TagSlugHistory table migrated")
+
+	// Migrate TagRelation (related tags, refreshed by background job)
+	if err := gormDB.AutoMigrate(&domain.TagRelation{}); err != nil {
+		return fmt.Errorf("migration failed for TagRelation: %w", err)
+	}
+	log.Println("✓ TagRelation table migrated")
+
+	// Migrate TagDisplayName (localized display names)
+	if err := gormDB.AutoMigrate(&domain.TagDisplayName{}); err != nil {
+		return fmt.Errorf("migration failed for TagDisplayName: %w", err)
+	}
+	log.Println("✓ TagDisplayName table migrated")
+
 	// Migrate User model
 	if err := gormDB.AutoMigrate(&domain.User{}); err != nil {
 		return fmt.Errorf("migration failed for User: %w", err)
@@ -128,6 +173,24 @@ func Migrate() error {
 	}
 	log.Println("✓ Session table migrated")
 
+	// Migrate TagProposal (user tag proposals, needs videos, users and canonical_tags)
+	if err := gormDB.AutoMigrate(&domain.TagProposal{}); err != nil {
+		return fmt.Errorf("migration failed for TagProposal: %w", err)
+	}
+	log.Println("✓ TagProposal table migrated")
+
+	// Migrate tag resolution policy (single row) and its audit trail
+	if err := gormDB.AutoMigrate(&domain.ResolutionPolicy{}, &domain.ResolutionPolicyChange{}); err != nil {
+		return fmt.Errorf("migration failed for ResolutionPolicy: %w", err)
+	}
+	log.Println("✓ ResolutionPolicy tables migrated")
+
+	// Migrate VideoImportJob (bulk YouTube imports)
+	if err := gormDB.AutoMigrate(&domain.VideoImportJob{}); err != nil {
+		return fmt.Errorf("migration failed for VideoImportJob: %w", err)
+	}
+	log.Println("✓ VideoImportJob table migrated")
+
 	// Add TSV column manually using raw SQL (GORM ignores it with gorm:"-")
 	if err := addTSVColumn(gormDB); err != nil {
 		log.Printf("⚠ Warning: could not add TSV column: %v", err)
@@ -135,6 +198,19 @@ func Migrate() error {
 		log.Println("✓ TSV column added successfully")
 	}
 
+	// Track when videos are tagged and backfill tag usage counters
+	if err := addTagUsageTracking(gormDB); err != nil {
+		return fmt.Errorf("failed to add tag usage tracking: %w", err)
+	}
+	log.Println("✓ Tag usage counters backfilled")
+
+	// Detect the language of aliases created before language detection existed
+	updated, err := backfillAliasLanguages(gormDB)
+	if err != nil {
+		return fmt.Errorf("failed to backfill alias languages: %w", err)
+	}
+	log.Printf("✓ Alias languages backfilled (%d aliases updated)", updated)
+
 	// Create necessary indexes for performance
 	if err := createIndexes(gormDB); err != nil {
 		return fmt.Errorf("failed to create indexes: %w", err)
@@ -189,6 +265,73 @@ func addTSVColumn(db *gorm.DB) error {
 	return nil
 }
 
+// addTagUsageTracking adds created_at to video_canonical_tags (GORM join table has none)
+// and recomputes canonical_tags.video_count / alias_count from scratch (idempotent, soft-deleted videos excluded)
+func addTagUsageTracking(db *gorm.DB) error {
+	if db == nil {
+		return fmt.Errorf("gorm.DB is nil in addTagUsageTracking")
+	}
+
+	statements := []string{
+		`ALTER TABLE video_canonical_tags ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ`,
+		// Existing links: best guess is when the video was added
+		`UPDATE video_canonical_tags vct SET created_at = v.created_at
+			FROM videos v WHERE v.id = vct.video_id AND vct.created_at IS NULL`,
+		`UPDATE video_canonical_tags SET created_at = NOW() WHERE created_at IS NULL`,
+		`ALTER TABLE video_canonical_tags ALTER COLUMN created_at SET DEFAULT NOW()`,
+		`ALTER TABLE video_canonical_tags ALTER COLUMN created_at SET NOT NULL`,
+		`UPDATE canonical_tags ct SET
+			video_count = (SELECT COUNT(*) FROM video_canonical_tags vct
+				JOIN videos v ON v.id = vct.video_id AND v.deleted_at IS NULL
+				WHERE vct.canonical_tag_id = ct.id),
+			alias_count = (SELECT COUNT(*) FROM tag_aliases ta WHERE ta.canonical_tag_id = ct.id)`,
+	}
+
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfillAliasLanguages sets tag_aliases.language with domain.DetectLanguage where it is still "unk"
+// Aliases the detector cannot identify stay "unk" (idempotent, safe to re-run)
+func backfillAliasLanguages(db *gorm.DB) (int, error) {
+	if db == nil {
+		return 0, fmt.Errorf("gorm.DB is nil in backfillAliasLanguages")
+	}
+
+	var aliases []domain.TagAlias
+	if err := db.Model(&domain.TagAlias{}).
+		Select("id", "raw_text").
+		Where("language = ? OR language IS NULL OR language = ''", domain.LanguageUnknown).
+		Find(&aliases).Error; err != nil {
+		return 0, err
+	}
+
+	// Group by detected language: one UPDATE per language
+	idsByLanguage := make(map[string][]uuid.UUID)
+	for _, alias := range aliases {
+		language := domain.DetectLanguage(alias.RawText)
+		if language != domain.LanguageUnknown {
+			idsByLanguage[language] = append(idsByLanguage[language], alias.ID)
+		}
+	}
+
+	updated := 0
+	for language, ids := range idsByLanguage {
+		result := db.Model(&domain.TagAlias{}).Where("id IN ?", ids).Update("language", language)
+		if result.Error != nil {
+			return updated, result.Error
+		}
+		updated += int(result.RowsAffected)
+	}
+
+	return updated, nil
+}
+
 // createIndexes creates additional indexes for performance
 func createIndexes(db *gorm.DB) error {
 	if db == nil {
@@ -212,8 +355,10 @@ func createIndexes(db *gorm.DB) error {
 		"CREATE INDEX IF NOT EXISTS idx_tag_aliases_canonical_tag_id ON tag_aliases(canonical_tag_id)",
 		"CREATE INDEX IF NOT EXISTS idx_tag_aliases_normalized ON tag_aliases(normalized_text)",
 		"CREATE UNIQUE INDEX IF NOT EXISTS idx_tag_aliases_normalized_unique ON tag_aliases(normalized_text)",
+		"CREATE INDEX IF NOT EXISTS idx_tag_aliases_review_queue ON tag_aliases(is_reviewed, similarity_score)",
 		"CREATE INDEX IF NOT EXISTS idx_video_canonical_tags_video_id ON video_canonical_tags(video_id)",
 		"CREATE INDEX IF NOT EXISTS idx_video_canonical_tags_canonical_tag_id ON video_canonical_tags(canonical_tag_id)",
+		"CREATE INDEX IF NOT EXISTS idx_video_canonical_tags_created_at ON video_canonical_tags(created_at, canonical_tag_id)",
 	}
 
 	for _, idx := range btreeIndexes {
@@ -231,6 +376,17 @@ func createIndexes(db *gorm.DB) error {
 		log.Println("  ✓ GIN index for Full Text Search created")
 	}
 
+	// Trigram indexes for video title/description search (LOWER(...) LIKE '%q%')
+	trigramIndexes := []string{
+		"CREATE INDEX IF NOT EXISTS idx_videos_title_trgm ON videos USING gin (LOWER(title) gin_trgm_ops)",
+		"CREATE INDEX IF NOT EXISTS idx_videos_description_trgm ON videos USING gin (LOWER(description) gin_trgm_ops)",
+	}
+	for _, idx := range trigramIndexes {
+		if err := db.Exec(idx).Error; err != nil {
+			log.Printf("Warning: failed to create trigram index (pg_trgm missing?): %v", err)
+		}
+	}
+
 	// HNSW vector index for semantic search on tag_aliases.embedding (Canonical-Alias)
 	hswVectorIndexSQL := `CREATE INDEX IF NOT EXISTS idx_tag_aliases_embedding_hnsw ON tag_aliases USING hnsw (embedding vector_cosine_ops) WITH (m = 16, ef_construction = 64)`
 	if err := db.Exec(hswVectorIndexSQL).Error; err != nil {
@@ -239,6 +395,36 @@ func createIndexes(db *gorm.DB) error {
 		log.Println("  ✓ HNSW vector index for tag_aliases.embedding created")
 	}
 
+	// HNSW vector index for centroid matching on canonical_tags.centroid, then backfill centroids computed before it existed
+	centroidIndexSQL := `CREATE INDEX IF NOT EXISTS idx_canonical_tags_centroid_hnsw ON canonical_tags USING hnsw (centroid vector_cosine_ops) WITH (m = 16, ef_construction = 64)`
+	if err := db.Exec(centroidIndexSQL).Error; err != nil {
+		log.Printf("Warning: failed to create HNSW vector index for canonical_tags (pgvector may not be installed): %v", err)
+	} else {
+		log.Println("  ✓ HNSW vector index for canonical_tags.centroid created")
+	}
+	centroidBackfillSQL := `
+		UPDATE canonical_tags ct
+		SET centroid = (
+			SELECT AVG(ta.embedding) FROM tag_aliases ta
+			WHERE ta.canonical_tag_id = ct.id AND ta.embedding IS NOT NULL
+		)
+		WHERE ct.centroid IS NULL
+			AND EXISTS (SELECT 1 FROM tag_aliases ta WHERE ta.canonical_tag_id = ct.id AND ta.embedding IS NOT NULL)
+	`
+	if result := db.Exec(centroidBackfillSQL); result.Error != nil {
+		log.Printf("Warning: failed to backfill canonical tag centroids: %v", result.Error)
+	} else {
+		log.Printf("  ✓ Centroids backfilled for %d canonical tags", result.RowsAffected)
+	}
+
+	// Trigram index for duplicate detection on canonical_tags.display_name
+	trgmIndexSQL := `CREATE INDEX IF NOT EXISTS idx_canonical_tags_display_name_trgm ON canonical_tags USING gin (display_name gin_trgm_ops)`
+	if err := db.Exec(trgmIndexSQL).Error; err != nil {
+		log.Printf("Warning: failed to create trigram index for canonical_tags (pg_trgm may not be installed): %v", err)
+	} else {
+		log.Println("  ✓ Trigram index for canonical_tags.display_name created")
+	}
+
 	return nil
 }
 
@@ -296,15 +482,30 @@ func Rollback() error {
 
 	// Drop all tables in reverse order of dependencies
 	if err := gormDB.Migrator().DropTable(
+		&domain.VideoImportJob{},
+		&domain.ResolutionPolicyChange{},
+		&domain.ResolutionPolicy{},
+		&domain.TagProposal{},
 		&domain.Session{},
 		&domain.SocialAccount{},
 		&domain.User{},
 		&domain.TranscriptSegment{},
 		&domain.Video{},
 		&domain.VideoTranscriptReview{},
+		&domain.TagAliasReviewLog{},
+		&domain.TagDuplicateCandidate{},
+		&domain.TagRelation{},
+		&domain.TagDisplayName{},
+		&domain.TagSlugHistory{},
+		&domain.TagMergeLogDisplayName{},
+		&domain.TagMergeLogSlug{},
+		&domain.TagMergeLogChild{},
+		&domain.TagMergeLogVideo{},
+		&domain.TagMergeLogAlias{},
+		&domain.TagMergeLog{},
 		&domain.TagAlias{},
 		&domain.CanonicalTag{},
-	).Error; err != nil {
+	); err != nil {
 		return fmt.Errorf("rollback failed: %w", err)
 	}
 
@@ -320,14 +521,29 @@ func Status() error {
 	log.Println("\n=== Database Migration Status ===")
 
 	models := map[string]interface{}{
-		"users":                    &domain.User{},
-		"social_accounts":          &domain.SocialAccount{},
-		"sessions":                 &domain.Session{},
-		"videos":                   &domain.Video{},
-		"transcript_segments":      &domain.TranscriptSegment{},
-		"video_transcript_reviews": &domain.VideoTranscriptReview{},
-		"canonical_tags":           &domain.CanonicalTag{},
-		"tag_aliases":              &domain.TagAlias{},
+		"users":                         &domain.User{},
+		"social_accounts":               &domain.SocialAccount{},
+		"sessions":                      &domain.Session{},
+		"videos":                        &domain.Video{},
+		"transcript_segments":           &domain.TranscriptSegment{},
+		"video_transcript_reviews":      &domain.VideoTranscriptReview{},
+		"canonical_tags":                &domain.CanonicalTag{},
+		"tag_aliases":                   &domain.TagAlias{},
+		"tag_alias_review_logs":         &domain.TagAliasReviewLog{},
+		"tag_duplicate_candidates":      &domain.TagDuplicateCandidate{},
+		"tag_merge_logs":                &domain.TagMergeLog{},
+		"tag_merge_log_aliases":         &domain.TagMergeLogAlias{},
+		"tag_merge_log_videos":          &domain.TagMergeLogVideo{},
+		"tag_merge_log_children":        &domain.TagMergeLogChild{},
+		"tag_merge_log_slugs":           &domain.TagMergeLogSlug{},
+		"tag_merge_log_display_names":   &domain.TagMergeLogDisplayName{},
+		"tag_slug_history":              &domain.TagSlugHistory{},
+		"tag_relations":                 &domain.TagRelation{},
+		"tag_display_names":             &domain.TagDisplayName{},
+		"tag_proposals":                 &domain.TagProposal{},
+		"tag_resolution_policies":       &domain.ResolutionPolicy{},
+		"tag_resolution_policy_changes": &domain.ResolutionPolicyChange{},
+		"video_import_jobs":             &domain.VideoImportJob{},
 	}
 
 	for name, model := range models {
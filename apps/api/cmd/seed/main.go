@@ -11,7 +11,8 @@ func main() {
 	// Định nghĩa các flags để chọn chạy cái gì
 	jsonFile := flag.String("json", "../../tsv_files/clean_videos.json", "Đường dẫn file JSON metadata video")
 	tsvDir := flag.String("tsv", "../../tsv_files/ttt-3", "Thư mục chứa file TSV")
-	action := flag.String("action", "all", "Chọn action: videos, transcripts, all")
+	csvFile := flag.String("csv", "../../tsv_files/video_tags.csv", "Đường dẫn file CSV tag (youtube_id,tag1;tag2)")
+	action := flag.String("action", "all", "Chọn action: videos, transcripts, tags, all (tags không nằm trong all)")
 	flag.Parse()
 
 	// 1. Kết nối DB
@@ -46,4 +47,15 @@ func main() {
 			log.Fatalf("Lỗi Import Transcripts: %v", err)
 		}
 	}
+
+	// 4. Chạy Import Tags (CSV) - chỉ khi chọn riêng, vì có thể gọi OpenAI
+	if *action == "tags" {
+		if _, err := os.Stat(*csvFile); os.IsNotExist(err) {
+			log.Fatalf("File CSV không tồn tại: %s", *csvFile)
+		}
+		err = ImportVideoTagsCSV(gormDB, *csvFile)
+		if err != nil {
+			log.Fatalf("Lỗi Import Tags: %v", err)
+		}
+	}
 }
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"api/internal/infrastructure"
+	"api/internal/repository"
+	"api/internal/service"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Số dòng CSV xử lý mỗi lần gọi ImportVideoTags (mỗi lần = 1 lần gọi BatchGetEmbeddings cho tag mới)
+const tagImportChunkSize = 200
+
+// ImportVideoTagsCSV gắn tag cho video từ file CSV: youtube_id,tag1;tag2;...
+// Tag được resolve qua 4-layer ResolveTag (giống POST /videos/:id/tags), mỗi tên chỉ resolve 1 lần
+func ImportVideoTagsCSV(db *gorm.DB, csvPath string) error {
+	log.Println("--- BẮT ĐẦU IMPORT TAGS ---")
+
+	file, err := os.Open(csvPath)
+	if err != nil {
+		return fmt.Errorf("không thể mở file csv: %w", err)
+	}
+	defer file.Close()
+
+	// 1. Map [YoutubeID] -> [UUID]
+	var vidList []struct {
+		ID        uuid.UUID
+		YoutubeID string
+	}
+	if err := db.Model(&domain.Video{}).Select("id, youtube_id").Scan(&vidList).Error; err != nil {
+		return fmt.Errorf("lỗi load video map: %w", err)
+	}
+	vidMap := make(map[string]uuid.UUID, len(vidList))
+	for _, v := range vidList {
+		vidMap[v.YoutubeID] = v.ID
+	}
+
+	// 2. Đọc CSV
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1 // Dòng có thể thiếu cột tag
+
+	var assignments []dto.VideoTagAssignment
+	skipped := 0
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Printf("⚠️  Dòng %d lỗi: %v", line, err)
+			skipped++
+			continue
+		}
+		if len(record) < 2 {
+			skipped++
+			continue
+		}
+
+		youtubeID := strings.TrimSpace(record[0])
+		if line == 1 && youtubeID == "youtube_id" {
+			continue // Header
+		}
+
+		videoID, exists := vidMap[youtubeID]
+		if !exists {
+			log.Printf("⚠️  Bỏ qua dòng %d: không tìm thấy video %s", line, youtubeID)
+			skipped++
+			continue
+		}
+
+		var tags []string
+		for _, tag := range strings.Split(record[1], ";") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+		if len(tags) == 0 {
+			skipped++
+			continue
+		}
+
+		assignments = append(assignments, dto.VideoTagAssignment{VideoID: videoID.String(), Tags: tags})
+	}
+	log.Printf("... Đã đọc %d dòng hợp lệ, bỏ qua %d dòng.", len(assignments), skipped)
+
+	// 3. Khởi tạo tag service (OpenAI optional: thiếu key thì tag mới được tạo không có embedding)
+	openAIClient, err := infrastructure.NewOpenAIClient()
+	if err != nil {
+		log.Printf("⚠️  Không có OpenAI client (%v) - bỏ qua semantic matching", err)
+		openAIClient = nil
+	}
+	tagService := service.NewTagServiceV2(
+		repository.NewTagRepository(db, openAIClient),
+		repository.NewVideoRepository(db),
+	)
+
+	// 4. Import theo từng chunk
+	ctx := context.Background()
+	var added, alreadyTagged, failed, newTags int
+	for start := 0; start < len(assignments); start += tagImportChunkSize {
+		chunk := assignments[start:min(start+tagImportChunkSize, len(assignments))]
+
+		result, err := tagService.ImportVideoTags(ctx, chunk)
+		if err != nil {
+			return fmt.Errorf("lỗi import tags (dòng %d-%d): %w", start+1, start+len(chunk), err)
+		}
+
+		added += result.AddedCount
+		alreadyTagged += result.SkippedCount
+		failed += result.FailedCount
+		for _, t := range result.Tags {
+			if t.IsNew {
+				newTags++
+			}
+			if t.Error != "" {
+				log.Printf("❌ Tag '%s': %s", t.Input, t.Error)
+			}
+		}
+		fmt.Printf("\nĐã xử lý %d/%d video...", start+len(chunk), len(assignments))
+	}
+
+	log.Printf("\n✅ HOÀN TẤT! %d liên kết mới, %d đã có, %d lỗi, %d tag mới được tạo.", added, alreadyTagged, failed, newTags)
+	return nil
+}
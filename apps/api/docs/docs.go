@@ -24,6 +24,86 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/admin/videos/trash": {
+            "get": {
+                "description": "Soft-deleted videos, most recently deleted first. Videos are purged automatically after VIDEO_TRASH_RETENTION_DAYS (admin only).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Videos"
+                ],
+                "summary": "List deleted videos",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TrashedVideoListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/videos/trash/{id}": {
+            "delete": {
+                "description": "Hard delete a soft-deleted video with its transcript segments, tag links, reviews and tag proposals.\nFrees the YouTube ID so the video can be added again. Cannot be undone (admin only).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Videos"
+                ],
+                "summary": "Permanently delete a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/auth/google": {
             "get": {
                 "description": "Redirect to Google OAuth consent page",
@@ -407,6 +487,132 @@ const docTemplate = `{
                 }
             }
         },
+        "/mod/imports": {
+            "get": {
+                "description": "Import jobs, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Videos"
+                ],
+                "summary": "List import jobs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VideoImportJobListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Import all uploads of a channel, all items of a playlist, or a list of video URLs/IDs (exactly one source).\nRuns as a background job: IDs are paged 50 at a time, existing videos (including soft-deleted) are skipped.\nPoll GET /mod/imports/{id} for progress. Channel and playlist imports need YOUTUBE_API_KEY.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Videos"
+                ],
+                "summary": "Bulk import videos from YouTube",
+                "parameters": [
+                    {
+                        "description": "Import source",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateVideoImportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VideoImportJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "YouTube API not configured",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mod/imports/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Videos"
+                ],
+                "summary": "Get import job progress",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import job ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VideoImportJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/mod/videos": {
             "get": {
                 "description": "Get paginated videos for mod/admin dashboard with tag information",
@@ -440,7 +646,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Search by title",
+                        "description": "Search by title or description",
                         "name": "q",
                         "in": "query"
                     },
@@ -497,7 +703,7 @@ const docTemplate = `{
                 "summary": "Create video from YouTube",
                 "parameters": [
                     {
-                        "description": "YouTube video ID",
+                        "description": "YouTube video ID or URL (watch, youtu.be, shorts, embed, live, music)",
                         "name": "request",
                         "in": "body",
                         "required": true,
@@ -520,9 +726,9 @@ const docTemplate = `{
                         }
                     },
                     "409": {
-                        "description": "Video already exists",
+                        "description": "Video already exists (deleted=true: restore it instead)",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.VideoDuplicateResponse"
                         }
                     }
                 }
@@ -530,7 +736,7 @@ const docTemplate = `{
         },
         "/mod/videos/preview/{id}": {
             "get": {
-                "description": "Fetch YouTube metadata without saving (mod/admin only)",
+                "description": "Fetch YouTube metadata without saving (mod/admin only).\nPass an ID in the path, or any YouTube URL as ?url= on /mod/videos/preview. existing_video_id is set when the video is already stored.",
                 "consumes": [
                     "application/json"
                 ],
@@ -548,6 +754,12 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "YouTube video URL (on /mod/videos/preview)",
+                        "name": "url",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -568,7 +780,7 @@ const docTemplate = `{
         },
         "/mod/videos/search": {
             "get": {
-                "description": "Search videos by title (mod/admin only)",
+                "description": "Search videos by title and description (mod/admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -651,11 +863,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/mod/videos/{id}/transcript/segments": {
-            "post": {
-                "description": "Add a new transcript segment to a video",
+            },
+            "patch": {
+                "description": "Change title, description, thumbnail, duration or publish date (mod/admin only). Only the fields sent are changed.\nSend the updated_at from the last read: 409 if the video changed since. Edited fields are kept by the scheduled\nYouTube refresh (see manual_fields) until listed in unlock_fields.",
                 "consumes": [
                     "application/json"
                 ],
@@ -665,7 +875,7 @@ const docTemplate = `{
                 "tags": [
                     "Videos"
                 ],
-                "summary": "Create new transcript segment",
+                "summary": "Edit video metadata",
                 "parameters": [
                     {
                         "type": "string",
@@ -675,24 +885,24 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Segment data",
+                        "description": "Fields to change",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateSegmentRequest"
+                            "$ref": "#/definitions/dto.UpdateVideoRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.SegmentResponse"
+                            "$ref": "#/definitions/dto.ModVideoResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid field values",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -702,57 +912,44 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
+                    },
+                    "409": {
+                        "description": "Modified since updated_at",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/search/tags": {
-            "get": {
-                "description": "Semantic search using vector embeddings and cosine similarity",
-                "consumes": [
-                    "application/json"
-                ],
+        "/mod/videos/{id}/restore": {
+            "post": {
+                "description": "Undo a soft delete; tags and transcript are kept (mod/admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Search"
+                    "Videos"
                 ],
-                "summary": "Search tags by semantic similarity",
+                "summary": "Restore a deleted video",
                 "parameters": [
                     {
-                        "minLength": 2,
                         "type": "string",
-                        "description": "Search query",
-                        "name": "q",
-                        "in": "query",
+                        "description": "Video ID (UUID)",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "maximum": 10,
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 5,
-                        "description": "Number of results",
-                        "name": "limit",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TagSearchResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.VideoCreateResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "No deleted video with this ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -760,9 +957,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/search/transcript": {
-            "get": {
-                "description": "Full-text search across all transcript segments using PostgreSQL FTS",
+        "/mod/videos/{id}/transcript/segments": {
+            "post": {
+                "description": "Add a new transcript segment to a video",
                 "consumes": [
                     "application/json"
                 ],
@@ -770,12 +967,119 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Search"
+                    "Videos"
                 ],
-                "summary": "Search transcripts by text",
+                "summary": "Create new transcript segment",
                 "parameters": [
                     {
-                        "minLength": 2,
+                        "type": "string",
+                        "description": "Video ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Segment data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateSegmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SegmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search/tags": {
+            "get": {
+                "description": "Semantic search using vector embeddings and cosine similarity",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search tags by semantic similarity",
+                "parameters": [
+                    {
+                        "minLength": 2,
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 10,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Number of results",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagSearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search/transcript": {
+            "get": {
+                "description": "Full-text search across all transcript segments using PostgreSQL FTS",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search transcripts by text",
+                "parameters": [
+                    {
+                        "minLength": 2,
                         "type": "string",
                         "description": "Search query (supports PostgreSQL websearch syntax)",
                         "name": "q",
@@ -814,6 +1118,173 @@ const docTemplate = `{
                 }
             }
         },
+        "/tags/by-slug/{slug}": {
+            "get": {
+                "description": "Resolve a slug to its tag. Historic slugs (from renames) resolve to the current tag with redirected=true.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get tag by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name locale (e.g. vi, en); overrides Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagBySlugResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/trending": {
+            "get": {
+                "description": "Tags with the most videos tagged within the recent window",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get trending tags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 7,
+                        "description": "Window in days",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Max tags",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only approved tags",
+                        "name": "approved_only",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name locale (e.g. vi, en); overrides Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TrendingTagResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}/related": {
+            "get": {
+                "description": "\"See also\" tags, scored by co-occurrence on videos (Jaccard) blended with alias-embedding proximity.\nRelations are refreshed periodically by a background job.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get related tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Canonical Tag ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Max related tags",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only approved related tags",
+                        "name": "approved_only",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name locale (e.g. vi, en); overrides Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.RelatedTagResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/transcript-segments/{id}": {
             "patch": {
                 "description": "Update text content of a single transcript segment",
@@ -1102,23 +1573,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/v2/mod/tags": {
+        "/v1/auth/me/tag-proposals": {
             "get": {
-                "description": "Get paginated list of canonical tags with optional search",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Proposal history of the signed-in user, newest first, with review outcome and rejection reason",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Tags"
                 ],
-                "summary": "List canonical tags",
+                "summary": "My tag proposals",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
+                        "type": "string",
+                        "description": "pending, approved or rejected (default: all)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
                         "description": "Page number",
                         "name": "page",
                         "in": "query"
@@ -1129,31 +1603,33 @@ const docTemplate = `{
                         "description": "Items per page",
                         "name": "limit",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search query",
-                        "name": "query",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TagListResponse"
+                            "$ref": "#/definitions/dto.TagProposalListResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/videos/{id}/tag-proposals": {
             "post": {
-                "description": "Create a canonical tag using 4-layer resolution. Automatically merges similar tags.\nReturns existing tag if semantically similar (\u003e85% similarity).",
+                "description": "Any signed-in user can propose a tag. It is linked to the video only after a mod approves it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1163,85 +1639,86 @@ const docTemplate = `{
                 "tags": [
                     "Tags"
                 ],
-                "summary": "Create a new canonical tag (v2 - with auto-resolution)",
+                "summary": "Propose a tag for a video",
                 "parameters": [
                     {
-                        "description": "Tag data",
-                        "name": "tag",
+                        "type": "string",
+                        "description": "Video ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Proposed tag",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateTagRequest"
+                            "$ref": "#/definitions/dto.CreateTagProposalRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Existing similar tag returned (auto-merged)",
-                        "schema": {
-                            "$ref": "#/definitions/dto.CanonicalTagResponse"
-                        }
-                    },
                     "201": {
-                        "description": "Tag created",
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.CanonicalTagResponse"
+                            "$ref": "#/definitions/dto.TagProposalResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already proposed or already tagged",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
                     }
                 }
             }
         },
-        "/v2/mod/tags/merge": {
-            "post": {
-                "description": "Manually merge source tag into target tag. Source becomes an alias of target.\nAll aliases and video relationships are transferred to target.\nSource canonical tag is deleted after merge.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/v2/admin/tags/export": {
+            "get": {
+                "description": "Download all canonical tags (slug, display name, approval, parent, aliases with language)\nas a versioned JSON file. The file is returned as-is (not wrapped) so it can be imported directly.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Tags"
                 ],
-                "summary": "Manually merge tags",
+                "summary": "Export tag taxonomy",
                 "parameters": [
                     {
-                        "description": "Source and target tag IDs",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.MergeTagsRequest"
-                        }
+                        "type": "boolean",
+                        "description": "Include alias embeddings (large)",
+                        "name": "include_embeddings",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Merge successful",
-                        "schema": {
-                            "$ref": "#/definitions/dto.APIResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request or tags are the same",
-                        "schema": {
-                            "$ref": "#/definitions/dto.APIResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Source or target tag not found",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.APIResponse"
+                            "$ref": "#/definitions/dto.TaxonomyFile"
                         }
                     },
                     "500": {
-                        "description": "Internal error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/dto.APIResponse"
                         }
@@ -1249,9 +1726,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v2/mod/tags/search": {
-            "get": {
-                "description": "Search canonical tags by name (hybrid: SQL LIKE + Vector similarity)",
+        "/v2/admin/tags/import": {
+            "post": {
+                "description": "Upsert canonical tags by slug (current or historic) and aliases by normalized text.\nAliases already pointing to another canonical are reported as conflicts, never moved.\nMissing embeddings are regenerated after the import. Use dry_run=true to only get the report.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1261,90 +1738,73 @@ const docTemplate = `{
                 "tags": [
                     "Tags"
                 ],
-                "summary": "Search canonical tags",
+                "summary": "Import tag taxonomy",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Search query",
-                        "name": "q",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Max results",
-                        "name": "limit",
+                        "type": "boolean",
+                        "description": "Report only, write nothing",
+                        "name": "dry_run",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "default": false,
-                        "description": "Only return approved tags",
-                        "name": "approved_only",
-                        "in": "query"
+                        "description": "Taxonomy file (from export)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaxonomyFile"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.TagResponse"
-                            }
+                            "$ref": "#/definitions/dto.TaxonomyImportResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid file or unsupported version",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     }
                 }
             }
         },
-        "/v2/mod/tags/{id}": {
+        "/v2/admin/tags/resolution-policy": {
             "get": {
-                "description": "Get canonical tag details by tag ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Runtime settings of the tag resolver: auto-merge distance (global and per language), match strategy (alias_min, centroid, hybrid), merge mode,\ntranslation layer, alias auto-approval and behaviour when OpenAI is unavailable.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Tags"
                 ],
-                "summary": "Get canonical tag by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Canonical Tag ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "Get tag resolution policy",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TagResponse"
+                            "$ref": "#/definitions/dto.ResolutionPolicyResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     }
                 }
-            }
-        },
-        "/v2/mod/tags/{id}/approve": {
+            },
             "patch": {
-                "description": "Update the approval status of a canonical tag",
+                "description": "Change any subset of the settings. Takes effect without restart; every change is recorded with its author and reason.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1354,46 +1814,33 @@ const docTemplate = `{
                 "tags": [
                     "Tags"
                 ],
-                "summary": "Update tag approval status",
+                "summary": "Update tag resolution policy",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Canonical Tag ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Approval status",
+                        "description": "Settings to change",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateTagApprovalRequest"
+                            "$ref": "#/definitions/dto.UpdateResolutionPolicyRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Update successful",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.APIResponse"
+                            "$ref": "#/definitions/dto.ResolutionPolicyResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.APIResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Tag not found",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/dto.APIResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/dto.APIResponse"
                         }
@@ -1401,48 +1848,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/v2/mod/videos/{id}/tags": {
+        "/v2/admin/tags/resolution-policy/changes": {
             "get": {
-                "description": "Get all canonical tags for a video",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Who changed the resolution policy, when, why, and the settings before/after. Newest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Videos"
+                    "Tags"
                 ],
-                "summary": "Get video canonical tags",
+                "summary": "Resolution policy audit trail",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Video ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.TagResponse"
-                            }
+                            "$ref": "#/definitions/dto.ResolutionPolicyChangeListResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Add a tag to a video using 4-layer resolution. Automatically merges similar tags.\nNo 409 conflict errors - system handles duplicates transparently.",
+            }
+        },
+        "/v2/mod/tags": {
+            "get": {
+                "description": "Get paginated list of canonical tags with optional search",
                 "consumes": [
                     "application/json"
                 ],
@@ -1450,30 +1900,106 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Videos"
+                    "Tags"
                 ],
-                "summary": "Add canonical tag to video (with auto-resolution)",
+                "summary": "List canonical tags",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Video ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Search query",
+                        "name": "query",
+                        "in": "query"
                     },
                     {
-                        "description": "Tag info (provide tag_id OR tag_name)",
-                        "name": "request",
+                        "type": "boolean",
+                        "description": "Return the topic tree with per-node video counts (ignores pagination)",
+                        "name": "tree",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "popular",
+                            "growing"
+                        ],
+                        "type": "string",
+                        "default": "name",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 7,
+                        "description": "Growth window in days for sort=growing",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "When tree=true",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TagTreeNodeResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a canonical tag using 4-layer resolution. Automatically merges similar tags.\nReturns existing tag if semantically similar (threshold and merge mode come from the resolution policy).\nConcurrent requests for the same new name are serialized: one creates the tag, the others get it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Create a new canonical tag (v2 - with auto-resolution)",
+                "parameters": [
+                    {
+                        "description": "Tag data",
+                        "name": "tag",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.AddVideoTagRequest"
+                            "$ref": "#/definitions/dto.CreateTagRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Tag added successfully (may be auto-merged)",
+                        "description": "Existing similar tag returned (auto-merged)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CanonicalTagResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Tag created",
                         "schema": {
                             "$ref": "#/definitions/dto.CanonicalTagResponse"
                         }
@@ -1484,40 +2010,36 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "No free slug, or the concurrently created tag was removed (retry)",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "OpenAI unavailable and the resolution policy forbids creating tags without embedding",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     }
                 }
             }
         },
-        "/v2/mod/videos/{id}/tags/{tag_id}": {
+        "/v2/mod/tags/aliases/{alias_id}": {
             "delete": {
-                "description": "Remove a canonical tag from a video",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Delete an alias. Refused if it is the last alias of its canonical tag.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Videos"
+                    "Tags"
                 ],
-                "summary": "Remove canonical tag from video",
+                "summary": "Delete alias",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Video ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Canonical Tag ID (UUID)",
-                        "name": "tag_id",
+                        "description": "Alias ID (UUID)",
+                        "name": "alias_id",
                         "in": "path",
                         "required": true
                     }
@@ -1526,18 +2048,30 @@ const docTemplate = `{
                     "204": {
                         "description": "No Content"
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Alias is the last one of its canonical tag",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     }
                 }
             }
         },
-        "/videos": {
-            "get": {
-                "description": "Get a paginated list of videos with optional filtering and sorting",
+        "/v2/mod/tags/aliases/{alias_id}/move": {
+            "post": {
+                "description": "Re-point an alias to a different canonical tag (e.g. fix a wrong auto-merge). Marks the alias as reviewed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1545,70 +2079,58 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Videos"
+                    "Tags"
                 ],
-                "summary": "List videos with pagination",
+                "summary": "Move alias to another canonical tag",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 50,
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "newest",
-                            "popular",
-                            "views"
-                        ],
                         "type": "string",
-                        "description": "Sort order",
-                        "name": "sort",
-                        "in": "query"
+                        "description": "Alias ID (UUID)",
+                        "name": "alias_id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by tag ID (UUID)",
-                        "name": "tag_id",
-                        "in": "query"
+                        "description": "Target canonical tag",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MoveAliasRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.VideoListResponse"
+                            "$ref": "#/definitions/dto.TagAliasResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Alias is the last one of its canonical tag",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     }
                 }
             }
         },
-        "/videos/{id}": {
-            "get": {
-                "description": "Get detailed information about a specific video including tags",
+        "/v2/mod/tags/aliases/{alias_id}/review": {
+            "patch": {
+                "description": "Mark an alias as reviewed (recorded as an accept by the current moderator) or put it back in the review queue",
                 "consumes": [
                     "application/json"
                 ],
@@ -1616,42 +2138,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Videos"
+                    "Tags"
                 ],
-                "summary": "Get video details",
+                "summary": "Update alias review status",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Video ID (UUID)",
-                        "name": "id",
+                        "description": "Alias ID (UUID)",
+                        "name": "alias_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Review status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateAliasReviewRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.VideoDetailResponse"
+                            "$ref": "#/definitions/dto.TagAliasResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     }
                 }
             }
         },
-        "/videos/{id}/reviews": {
+        "/v2/mod/tags/aliases/{alias_id}/split": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Moderator submits a review after verifying video transcript. Awards points and updates video status if threshold met.",
+                "description": "Detach an alias and create a new canonical tag for it",
                 "consumes": [
                     "application/json"
                 ],
@@ -1659,113 +2191,125 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Video Reviews"
+                    "Tags"
                 ],
-                "summary": "Submit transcript review for a video",
+                "summary": "Split alias into its own canonical tag",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Video ID (UUID)",
-                        "name": "id",
+                        "description": "Alias ID (UUID)",
+                        "name": "alias_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Optional review notes",
+                        "description": "Optional display name for the new canonical tag",
                         "name": "request",
                         "in": "body",
                         "schema": {
-                            "$ref": "#/definitions/dto.SubmitReviewRequest"
+                            "$ref": "#/definitions/dto.SplitAliasRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.VideoTranscriptReviewResponse"
+                            "$ref": "#/definitions/dto.TagResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request or user already reviewed",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     },
                     "404": {
-                        "description": "Video not found",
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Last alias or slug already taken",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     }
                 }
             }
         },
-        "/videos/{id}/reviews/stats": {
+        "/v2/mod/tags/duplicates": {
             "get": {
-                "description": "Get the number of reviews for a specific video",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Pending pairs of canonical tags that are likely duplicates, highest score first.\nEvidence includes the closest alias pair, slug/trigram similarity and co-occurring videos.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Video Reviews"
+                    "Tags"
                 ],
-                "summary": "Get review statistics for a video",
+                "summary": "List duplicate tag suggestions",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Video ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Returns {video_id, review_count}",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.TagDuplicateListResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     }
                 }
             }
         },
-        "/videos/{id}/reviews/status": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+        "/v2/mod/tags/duplicates/scan": {
+            "post": {
+                "description": "Run the duplicate detection job immediately instead of waiting for the next scheduled run",
+                "produces": [
+                    "application/json"
                 ],
-                "description": "Check whether the authenticated user has already reviewed a specific video",
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Run duplicate detection now",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DuplicateScanResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/duplicates/{id}/accept": {
+            "post": {
+                "description": "Merge the pair using MergeTags. target_id selects the tag to keep; defaults to the approved (or older) tag.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1773,64 +2317,68 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Video Reviews"
+                    "Tags"
                 ],
-                "summary": "Check if user has reviewed a video",
+                "summary": "Accept duplicate suggestion",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Video ID (UUID)",
+                        "type": "integer",
+                        "description": "Duplicate candidate ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Tag to keep",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AcceptDuplicateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Returns {video_id, has_reviewed}",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.MergeTagsResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     }
                 }
             }
         },
-        "/videos/{id}/transcript": {
-            "get": {
-                "description": "Get all transcript segments for a specific video",
-                "consumes": [
-                    "application/json"
-                ],
+        "/v2/mod/tags/duplicates/{id}/dismiss": {
+            "post": {
+                "description": "Mark the pair as not a duplicate; it will not be suggested again",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Videos"
+                    "Tags"
                 ],
-                "summary": "Get video transcript",
+                "summary": "Dismiss duplicate suggestion",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Video ID (UUID)",
+                        "type": "integer",
+                        "description": "Duplicate candidate ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1840,143 +2388,2805 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TranscriptResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.APIResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "description": "Response payload (nil for errors)"
-                },
-                "error": {
-                    "description": "Error details (nil for success)",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/dto.ErrorDetail"
+        },
+        "/v2/mod/tags/merge": {
+            "post": {
+                "description": "Manually merge source tag into target tag. Source becomes an alias of target.\nAll aliases and video relationships are transferred to target.\nSource canonical tag is deleted after merge.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Manually merge tags",
+                "parameters": [
+                    {
+                        "description": "Source and target tag IDs",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MergeTagsRequest"
                         }
-                    ]
-                },
-                "message": {
-                    "description": "Human-readable message",
-                    "type": "string"
-                },
-                "metadata": {
-                    "description": "Additional context",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/dto.Metadata"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Merge successful",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
-                    ]
-                },
-                "request_id": {
-                    "description": "For distributed tracing",
-                    "type": "string"
-                },
-                "status": {
-                    "description": "success/created/merged/error/conflict",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/dto.ResponseStatus"
+                    },
+                    "400": {
+                        "description": "Invalid request or tags are the same",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
                         }
-                    ]
-                },
-                "timestamp": {
-                    "description": "Response timestamp (ISO 8601)",
-                    "type": "string"
+                    },
+                    "404": {
+                        "description": "Source or target tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
                 }
             }
         },
-        "dto.AddVideoTagRequest": {
-            "type": "object",
-            "properties": {
-                "tag_id": {
-                    "description": "Existing tag ID",
-                    "type": "string"
-                },
-                "tag_name": {
-                    "description": "Create new tag if not exists",
-                    "type": "string"
+        "/v2/mod/tags/merges/{id}/undo": {
+            "post": {
+                "description": "Restore the source tag of a merge with its original ID, aliases and video relations.\nRefused with 409 if the merged tags changed since (aliases moved/deleted, video links removed, slug reused).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Undo a tag merge",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Merge ID (merge_id from the merge response)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UndoMergeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid merge ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Merge not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already undone or tags changed since merge",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
                 }
             }
         },
-        "dto.AuthResponse": {
-            "type": "object",
-            "properties": {
-                "token": {
-                    "description": "JWT token (omitted when using cookies)",
-                    "type": "string"
+        "/v2/mod/tags/proposals": {
+            "get": {
+                "description": "Proposals from regular users. Defaults to the pending queue, oldest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Tag proposal queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "pending (default), approved, rejected or all",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagProposalListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/proposals/{id}/approve": {
+            "post": {
+                "description": "Resolve the proposed name (or use tag_id) and link the tag to the video.\napprove_tag also marks the canonical tag approved.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Approve tag proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Proposal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Overrides",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ApproveTagProposalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagProposalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/proposals/{id}/reject": {
+            "post": {
+                "description": "Close the proposal without linking the tag. The reason is shown to the proposer.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Reject tag proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Proposal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rejection reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RejectTagProposalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagProposalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/related/refresh": {
+            "post": {
+                "description": "Run the related tags job immediately instead of waiting for the next scheduled run",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Recompute related tags now",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RelatedTagsRefreshResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/resolve": {
+            "post": {
+                "description": "With dry_run=true, explains each resolution layer (exact, translation, embedding, vector threshold)\nand the decision that would be taken, without creating aliases or canonicals.\nWithout dry_run, behaves like POST /v2/mod/tags.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Resolve a tag name (optionally dry-run)",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Only explain the decision, write nothing",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Number of nearest aliases to return",
+                        "name": "top_k",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Tag name to resolve",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Resolution trace (dry-run)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagResolveTraceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/resolve-batch": {
+            "post": {
+                "description": "Resolve up to 50 tag names in one request (e.g. a pasted comma-separated list).\nExact matches use one lookup; only misses are translated and embedded, each in one OpenAI call.\nEach result shows the layer that matched. With video_id, all resolved tags are linked to the video in one transaction.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Resolve many tags at once",
+                "parameters": [
+                    {
+                        "description": "Tag names and optional video",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResolveTagBatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResolveTagBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Video not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/review-queue": {
+            "get": {
+                "description": "Paginated list of unreviewed aliases (AI auto-merged), lowest similarity first.\nEach entry shows nearest alternative canonicals and affected videos.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Alias review queue",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AliasReviewQueueResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/review-queue/stats": {
+            "get": {
+                "description": "Number of aliases accepted, reassigned and split by each mod over the last N days",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Alias review throughput per mod",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 7,
+                        "description": "Window in days",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AliasReviewStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/review-queue/{alias_id}/accept": {
+            "post": {
+                "description": "Confirm an alias on its current canonical tag",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Accept alias from review queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Alias ID (UUID)",
+                        "name": "alias_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagAliasResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/review-queue/{alias_id}/reassign": {
+            "post": {
+                "description": "Move an alias to another canonical tag and mark it reviewed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Reassign alias from review queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Alias ID (UUID)",
+                        "name": "alias_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target canonical tag",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MoveAliasRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagAliasResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/review-queue/{alias_id}/split": {
+            "post": {
+                "description": "Detach an alias into its own new canonical tag and mark it reviewed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Split alias from review queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Alias ID (UUID)",
+                        "name": "alias_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional display name for the new canonical tag",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SplitAliasRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/search": {
+            "get": {
+                "description": "Search canonical tags by name (hybrid: SQL LIKE + Vector similarity)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Search canonical tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Max results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Only return approved tags",
+                        "name": "approved_only",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TagResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/{id}": {
+            "get": {
+                "description": "Get canonical tag details by tag ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get canonical tag by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Canonical Tag ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a canonical tag. Without reassign_to the tag is unlinked from its videos and its aliases are deleted.\nWith reassign_to the tag is merged into that tag (videos and aliases move, undoable via /merges/{id}/undo).\nApproved tags can only be deleted by admins.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Delete canonical tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Canonical Tag ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag ID (UUID) that receives the videos and aliases",
+                        "name": "reassign_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Approved tag, admin required",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/{id}/aliases": {
+            "get": {
+                "description": "List all aliases of a canonical tag with similarity scores and review status",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "List aliases of a canonical tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Canonical Tag ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TagAliasResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/{id}/approve": {
+            "patch": {
+                "description": "Update the approval status of a canonical tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Update tag approval status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Canonical Tag ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Approval status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateTagApprovalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update successful",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/{id}/display-names": {
+            "put": {
+                "description": "Use an existing alias of the tag as its display name for a locale (default: the alias language).\nPublic endpoints pick the name from the lang query parameter or Accept-Language, falling back to the default name.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Promote alias to localized display name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Canonical Tag ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Alias and optional locale",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PromoteAliasDisplayNameRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, alias of another tag or unknown alias language",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag or alias not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/{id}/display-names/{locale}": {
+            "delete": {
+                "description": "Remove the display name of a locale; that locale falls back to the default name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Remove localized display name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Canonical Tag ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale (e.g. vi, en)",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag or display name not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/{id}/parent": {
+            "patch": {
+                "description": "Move a tag together with its whole subtree under another tag. parent_id = null moves it to the root.\nRefused if the new parent is the tag itself or one of its descendants.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Move tag in the topic tree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Canonical Tag ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New parent",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetTagParentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag or parent not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Move would create a cycle",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/tags/{id}/rename": {
+            "post": {
+                "description": "Rename a canonical tag. The slug is regenerated (with a numeric suffix on collision),\nthe old slug keeps resolving via /tags/by-slug and the old name is kept as an alias.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Rename canonical tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Canonical Tag ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New display name",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RenameTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RenameTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "No free slug for this name",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/videos/tags/bulk": {
+            "post": {
+                "description": "Apply a list of tags (names or canonical tag IDs) to a list of videos.\nEach distinct name is resolved once (embeddings batched in one OpenAI call).\nFailures are reported per item; the request only fails on database errors.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Bulk tag videos",
+                "parameters": [
+                    {
+                        "description": "Videos and tags",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BulkVideoTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BulkVideoTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/videos/{id}/tags": {
+            "get": {
+                "description": "Get all canonical tags for a video",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Videos"
+                ],
+                "summary": "Get video canonical tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TagResponse"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a tag to a video using 4-layer resolution. Automatically merges similar tags.\nNo 409 conflict errors - system handles duplicates transparently.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Videos"
+                ],
+                "summary": "Add canonical tag to video (with auto-resolution)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tag info (provide tag_id OR tag_name)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AddVideoTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag added successfully (may be auto-merged)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CanonicalTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/mod/videos/{id}/tags/{tag_id}": {
+            "delete": {
+                "description": "Remove a canonical tag from a video",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Videos"
+                ],
+                "summary": "Remove canonical tag from video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Canonical Tag ID (UUID)",
+                        "name": "tag_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/videos": {
+            "get": {
+                "description": "Get a paginated list of videos with optional filtering and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Videos"
+                ],
+                "summary": "List videos with pagination",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 50,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "popular",
+                            "views"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by tag ID (UUID)",
+                        "name": "tag_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "With tag_id: also include videos tagged with descendant tags",
+                        "name": "include_descendants",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VideoListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/videos/{id}": {
+            "get": {
+                "description": "Get detailed information about a specific video including tags",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Videos"
+                ],
+                "summary": "Get video details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag name locale (e.g. vi, en); overrides Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred tag name locales",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VideoDetailResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/videos/{id}/reviews": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moderator submits a review after verifying video transcript. Awards points and updates video status if threshold met.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Video Reviews"
+                ],
+                "summary": "Submit transcript review for a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional review notes",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SubmitReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VideoTranscriptReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or user already reviewed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Video not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/videos/{id}/reviews/stats": {
+            "get": {
+                "description": "Get the number of reviews for a specific video",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Video Reviews"
+                ],
+                "summary": "Get review statistics for a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Returns {video_id, review_count}",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/videos/{id}/reviews/status": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Check whether the authenticated user has already reviewed a specific video",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Video Reviews"
+                ],
+                "summary": "Check if user has reviewed a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Returns {video_id, has_reviewed}",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/videos/{id}/transcript": {
+            "get": {
+                "description": "Get all transcript segments for a specific video",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Videos"
+                ],
+                "summary": "Get video transcript",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TranscriptResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.APIResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Response payload (nil for errors)"
+                },
+                "error": {
+                    "description": "Error details (nil for success)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.ErrorDetail"
+                        }
+                    ]
+                },
+                "message": {
+                    "description": "Human-readable message",
+                    "type": "string"
+                },
+                "metadata": {
+                    "description": "Additional context",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.Metadata"
+                        }
+                    ]
+                },
+                "request_id": {
+                    "description": "For distributed tracing",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "success/created/merged/error/conflict",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.ResponseStatus"
+                        }
+                    ]
+                },
+                "timestamp": {
+                    "description": "Response timestamp (ISO 8601)",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AcceptDuplicateRequest": {
+            "type": "object",
+            "properties": {
+                "target_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "dto.AddVideoTagRequest": {
+            "type": "object",
+            "properties": {
+                "tag_id": {
+                    "description": "Existing tag ID",
+                    "type": "string"
+                },
+                "tag_name": {
+                    "description": "Create new tag if not exists",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AliasReviewQueueItem": {
+            "type": "object",
+            "properties": {
+                "affected_video_count": {
+                    "description": "Videos linked to current canonical",
+                    "type": "integer"
+                },
+                "affected_videos": {
+                    "description": "Sample of affected videos",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VideoRefResponse"
+                    }
+                },
+                "alias": {
+                    "$ref": "#/definitions/dto.TagAliasResponse"
+                },
+                "alternatives": {
+                    "description": "Nearest other canonicals",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CanonicalCandidateResponse"
+                    }
+                },
+                "current_tag": {
+                    "description": "Canonical the alias is attached to",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "dto.AliasReviewQueueResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AliasReviewQueueItem"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/dto.PaginationMetadata"
+                }
+            }
+        },
+        "dto.AliasReviewStatsResponse": {
+            "type": "object",
+            "properties": {
+                "pending_count": {
+                    "description": "Aliases still waiting for review",
+                    "type": "integer"
+                },
+                "reviewers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AliasReviewerStatsResponse"
+                    }
+                },
+                "since": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AliasReviewerStatsResponse": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "integer"
+                },
+                "last_reviewed_at": {
+                    "type": "string"
+                },
+                "reassigned": {
+                    "type": "integer"
+                },
+                "reviewer_id": {
+                    "type": "string"
+                },
+                "split": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ApproveTagProposalRequest": {
+            "type": "object",
+            "properties": {
+                "approve_tag": {
+                    "description": "Also mark the canonical tag approved",
+                    "type": "boolean"
+                },
+                "tag_id": {
+                    "description": "Link this canonical instead of resolving the proposed name",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "description": "JWT token (omitted when using cookies)",
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                }
+            }
+        },
+        "dto.BulkTagResolution": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Set when the input could not be resolved",
+                    "type": "string"
+                },
+                "input": {
+                    "type": "string"
+                },
+                "is_new": {
+                    "description": "A new canonical tag was created",
+                    "type": "boolean"
+                },
+                "tag": {
+                    "$ref": "#/definitions/dto.TagResponse"
+                }
+            }
+        },
+        "dto.BulkVideoTagItem": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "input": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "added, already_tagged, failed",
+                    "type": "string"
+                },
+                "tag_id": {
+                    "type": "string"
+                },
+                "video_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BulkVideoTagRequest": {
+            "type": "object",
+            "required": [
+                "tags",
+                "video_ids"
+            ],
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Machine Learning"
+                    ]
+                },
+                "video_ids": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.BulkVideoTagResponse": {
+            "type": "object",
+            "properties": {
+                "added_count": {
+                    "type": "integer"
+                },
+                "failed_count": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BulkVideoTagItem"
+                    }
+                },
+                "skipped_count": {
+                    "description": "already_tagged",
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BulkTagResolution"
+                    }
+                }
+            }
+        },
+        "dto.CanonicalCandidateResponse": {
+            "type": "object",
+            "properties": {
+                "canonical_id": {
+                    "type": "string"
+                },
+                "canonical_name": {
+                    "type": "string"
+                },
+                "distance": {
+                    "type": "number"
+                },
+                "similarity": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.CanonicalTagResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "Canonical tag ID",
+                    "type": "string"
+                },
+                "matched_alias": {
+                    "description": "Original user input (for UI feedback)",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Display name (canonical)",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateSegmentRequest": {
+            "type": "object",
+            "required": [
+                "end_time",
+                "start_time",
+                "text"
+            ],
+            "properties": {
+                "end_time": {
+                    "description": "Milliseconds, must be \u003e StartTime",
+                    "type": "integer"
+                },
+                "start_time": {
+                    "description": "Milliseconds",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "text": {
+                    "type": "string",
+                    "minLength": 1
+                }
+            }
+        },
+        "dto.CreateTagProposalRequest": {
+            "type": "object",
+            "required": [
+                "tag_name"
+            ],
+            "properties": {
+                "tag_name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Machine Learning"
+                }
+            }
+        },
+        "dto.CreateTagRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                }
+            }
+        },
+        "dto.CreateUserRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password",
+                "username"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "full_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 3
+                }
+            }
+        },
+        "dto.CreateVideoImportRequest": {
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "UC_x5XG1OV2P6uZZ5FSM9Ttw"
+                },
+                "max_videos": {
+                    "description": "Stop after this many IDs (default: all)",
+                    "type": "integer",
+                    "maximum": 5000,
+                    "minimum": 1
+                },
+                "playlist_id": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "PLBCF2DAC6FFB574DE"
+                },
+                "urls": {
+                    "description": "Watch/youtu.be URLs or bare IDs",
+                    "type": "array",
+                    "maxItems": 500,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.CreateVideoRequest": {
+            "type": "object",
+            "required": [
+                "youtube_id"
+            ],
+            "properties": {
+                "youtube_id": {
+                    "description": "Video ID or any YouTube video URL",
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 11,
+                    "example": "https://youtu.be/dQw4w9WgXcQ?t=42"
+                }
+            }
+        },
+        "dto.DeleteTagResponse": {
+            "type": "object",
+            "properties": {
+                "affected_alias_count": {
+                    "description": "Aliases deleted (or reassigned)",
+                    "type": "integer"
+                },
+                "affected_video_count": {
+                    "description": "Videos unlinked (or reassigned)",
+                    "type": "integer"
+                },
+                "deleted_tag_id": {
+                    "type": "string"
+                },
+                "merge_id": {
+                    "description": "Merge log ID when reassigned (undoable)",
+                    "type": "integer"
+                },
+                "reassigned_to": {
+                    "description": "Set when videos/aliases were moved via merge",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "dto.DuplicateScanResponse": {
+            "type": "object",
+            "properties": {
+                "pending_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ErrorDetail": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Machine-readable error code (e.g., \"TAG_DUPLICATE\")",
+                    "type": "string"
+                },
+                "details": {
+                    "description": "Additional context (e.g., suggestions)"
+                },
+                "field": {
+                    "description": "Field name for validation errors",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "Human-readable error message",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GoogleAuthURLResponse": {
+            "type": "object",
+            "properties": {
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MergeTagsRequest": {
+            "type": "object",
+            "required": [
+                "source_id",
+                "target_id"
+            ],
+            "properties": {
+                "source_id": {
+                    "description": "Tag to be merged (will become alias)",
+                    "type": "string"
+                },
+                "target_id": {
+                    "description": "Target canonical tag (will remain)",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MergeTagsResponse": {
+            "type": "object",
+            "properties": {
+                "merge_id": {
+                    "description": "Merge log ID (use with /merges/:id/undo)",
+                    "type": "integer"
+                },
+                "merged_alias_count": {
+                    "description": "Number of aliases moved",
+                    "type": "integer"
+                },
+                "merged_video_count": {
+                    "description": "Videos of source now linked to target",
+                    "type": "integer"
+                },
+                "source_tag_deleted": {
+                    "description": "Whether source canonical was deleted",
+                    "type": "boolean"
+                },
+                "target_tag": {
+                    "description": "The canonical tag that remains",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "dto.Metadata": {
+            "type": "object",
+            "properties": {
+                "auto_merged": {
+                    "description": "true if auto-merged with existing",
+                    "type": "boolean"
+                },
+                "canonical_name": {
+                    "description": "Canonical/normalized name",
+                    "type": "string"
+                },
+                "is_new_resource": {
+                    "description": "Tag operation metadata",
+                    "type": "boolean"
+                },
+                "layer_hit": {
+                    "description": "Which resolution layer matched (1/1.5/2/3/4)",
+                    "type": "integer"
+                },
+                "matched_via": {
+                    "description": "How match was found: \"exact\"|\"translation\"|\"vector\"|\"new\"",
+                    "type": "string"
+                },
+                "merged_into": {
+                    "description": "ID of resource merged into",
+                    "type": "string"
+                },
+                "original_input": {
+                    "description": "User's original input",
+                    "type": "string"
+                },
+                "pagination": {
+                    "description": "Pagination metadata (for list endpoints)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.PaginationMetadata"
+                        }
+                    ]
+                },
+                "processing_time_ms": {
+                    "description": "Performance metadata",
+                    "type": "integer"
+                },
+                "similarity_score": {
+                    "description": "Vector similarity score (0.0-1.0)",
+                    "type": "number"
+                },
+                "translated_from": {
+                    "description": "Original language input",
+                    "type": "string"
+                },
+                "translated_to": {
+                    "description": "Translated English term",
+                    "type": "string"
+                },
+                "translation_used": {
+                    "description": "true if Translation Layer was used",
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.ModVideoListResponse": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "videos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ModVideoResponse"
+                    }
+                }
+            }
+        },
+        "dto.ModVideoResponse": {
+            "type": "object",
+            "properties": {
+                "availability": {
+                    "description": "available | unavailable (deleted/private on YouTube)",
+                    "type": "string"
+                },
+                "category_id": {
+                    "description": "YouTube category ID (27 = Education)",
+                    "type": "string"
+                },
+                "channel_id": {
+                    "type": "string"
+                },
+                "channel_title": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "default_audio_language": {
+                    "description": "BCP-47, set by the channel",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "duration": {
+                    "type": "integer"
+                },
+                "has_captions": {
+                    "description": "Captions exist on YouTube",
+                    "type": "boolean"
+                },
+                "has_transcript": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "manual_fields": {
+                    "description": "Fields edited by a mod, kept by the metadata refresh",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "metadata_refreshed_at": {
+                    "description": "Last YouTube metadata refresh (nil = never)",
+                    "type": "string"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "review_count": {
+                    "description": "Number of reviews",
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagResponse"
+                    }
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "unavailable_since": {
+                    "description": "First refresh that found the video unavailable",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "view_count": {
+                    "type": "integer"
+                },
+                "youtube_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MoveAliasRequest": {
+            "type": "object",
+            "required": [
+                "target_id"
+            ],
+            "properties": {
+                "target_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.NearestAliasResponse": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "alias_id": {
+                    "type": "string"
+                },
+                "canonical_id": {
+                    "type": "string"
+                },
+                "canonical_name": {
+                    "type": "string"
+                },
+                "distance": {
+                    "description": "Cosine distance (lower = closer)",
+                    "type": "number"
+                },
+                "similarity": {
+                    "description": "1 - distance/2",
+                    "type": "number"
+                },
+                "within_threshold": {
+                    "description": "distance \u003c threshold (would auto-merge)",
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.PaginationMetadata": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total_items": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PromoteAliasDisplayNameRequest": {
+            "type": "object",
+            "required": [
+                "alias_id"
+            ],
+            "properties": {
+                "alias_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "locale": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 2,
+                    "example": "vi"
+                }
+            }
+        },
+        "dto.RejectTagProposalRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 1,
+                    "example": "Too generic for this video"
+                }
+            }
+        },
+        "dto.RelatedTagResponse": {
+            "type": "object",
+            "properties": {
+                "co_occurrence_count": {
+                    "description": "Videos tagged with both tags",
+                    "type": "integer"
+                },
+                "embedding_similarity": {
+                    "description": "Centroid similarity (0-1)",
+                    "type": "number"
+                },
+                "jaccard": {
+                    "description": "Co-occurrence Jaccard index",
+                    "type": "number"
+                },
+                "score": {
+                    "description": "0-1, higher = more related",
+                    "type": "number"
+                },
+                "tag": {
+                    "$ref": "#/definitions/dto.TagResponse"
+                }
+            }
+        },
+        "dto.RelatedTagsRefreshResponse": {
+            "type": "object",
+            "properties": {
+                "relation_count": {
+                    "description": "Relations stored (both directions)",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.RenameTagRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Artificial Intelligence"
+                }
+            }
+        },
+        "dto.RenameTagResponse": {
+            "type": "object",
+            "properties": {
+                "old_name": {
+                    "type": "string"
+                },
+                "old_slug": {
+                    "description": "Still resolves via /tags/by-slug/{slug}",
+                    "type": "string"
+                },
+                "tag": {
+                    "$ref": "#/definitions/dto.TagResponse"
+                }
+            }
+        },
+        "dto.ResolutionPolicyChangeListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ResolutionPolicyChangeResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/dto.PaginationMetadata"
+                }
+            }
+        },
+        "dto.ResolutionPolicyChangeResponse": {
+            "type": "object",
+            "properties": {
+                "after": {
+                    "$ref": "#/definitions/dto.ResolutionPolicySettingsResponse"
+                },
+                "before": {
+                    "$ref": "#/definitions/dto.ResolutionPolicySettingsResponse"
+                },
+                "changed_by": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ResolutionPolicyResponse": {
+            "type": "object",
+            "properties": {
+                "auto_approve_aliases": {
+                    "type": "boolean"
+                },
+                "auto_merge_distance": {
+                    "type": "number"
+                },
+                "auto_merge_similarity": {
+                    "description": "1 - distance/2",
+                    "type": "number"
+                },
+                "create_without_embedding": {
+                    "type": "boolean"
+                },
+                "language_distances": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                },
+                "match_strategy": {
+                    "description": "alias_min | centroid | hybrid",
+                    "type": "string"
+                },
+                "merge_mode": {
+                    "type": "string"
+                },
+                "translation_enabled": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "description": "nil = defaults, never changed",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ResolutionPolicySettingsResponse": {
+            "type": "object",
+            "properties": {
+                "auto_approve_aliases": {
+                    "type": "boolean"
+                },
+                "auto_merge_distance": {
+                    "type": "number"
+                },
+                "auto_merge_similarity": {
+                    "description": "1 - distance/2",
+                    "type": "number"
+                },
+                "create_without_embedding": {
+                    "type": "boolean"
+                },
+                "language_distances": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                },
+                "match_strategy": {
+                    "description": "alias_min | centroid | hybrid",
+                    "type": "string"
+                },
+                "merge_mode": {
+                    "type": "string"
+                },
+                "translation_enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.ResolveTagBatchRequest": {
+            "type": "object",
+            "required": [
+                "names"
+            ],
+            "properties": {
+                "names": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Machine Learning",
+                        "Học máy"
+                    ]
+                },
+                "video_id": {
+                    "description": "Link every resolved tag to this video (one transaction)",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ResolveTagBatchResponse": {
+            "type": "object",
+            "properties": {
+                "created_count": {
+                    "description": "New canonical tags",
+                    "type": "integer"
+                },
+                "failed_count": {
+                    "type": "integer"
+                },
+                "linked_count": {
+                    "description": "New video links (video_id only)",
+                    "type": "integer"
+                },
+                "resolved_count": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagBatchResolution"
+                    }
+                }
+            }
+        },
+        "dto.ResponseStatus": {
+            "type": "string",
+            "enum": [
+                "success",
+                "created",
+                "merged",
+                "error",
+                "not_found",
+                "conflict",
+                "invalid"
+            ],
+            "x-enum-comments": {
+                "StatusConflict": "Business logic conflict (HTTP 409)",
+                "StatusCreated": "New resource created (HTTP 201)",
+                "StatusError": "Generic error",
+                "StatusInvalid": "Invalid input (HTTP 400)",
+                "StatusMerged": "Resource auto-merged with existing (tag deduplication)",
+                "StatusNotFound": "Resource not found (HTTP 404)",
+                "StatusSuccess": "Operation completed successfully"
+            },
+            "x-enum-descriptions": [
+                "Operation completed successfully",
+                "New resource created (HTTP 201)",
+                "Resource auto-merged with existing (tag deduplication)",
+                "Generic error",
+                "Resource not found (HTTP 404)",
+                "Business logic conflict (HTTP 409)",
+                "Invalid input (HTTP 400)"
+            ],
+            "x-enum-varnames": [
+                "StatusSuccess",
+                "StatusCreated",
+                "StatusMerged",
+                "StatusError",
+                "StatusNotFound",
+                "StatusConflict",
+                "StatusInvalid"
+            ]
+        },
+        "dto.SegmentResponse": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "description": "Milliseconds",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "start_time": {
+                    "description": "Milliseconds",
+                    "type": "integer"
                 },
-                "user": {
-                    "$ref": "#/definitions/dto.UserResponse"
+                "text": {
+                    "type": "string"
                 }
             }
         },
-        "dto.CanonicalTagResponse": {
+        "dto.SessionResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "description": "Canonical tag ID",
+                "client_ip": {
                     "type": "string"
                 },
-                "matched_alias": {
-                    "description": "Original user input (for UI feedback)",
+                "created_at": {
                     "type": "string"
                 },
-                "name": {
-                    "description": "Display name (canonical)",
+                "expires_at": {
                     "type": "string"
-                }
-            }
-        },
-        "dto.CreateSegmentRequest": {
-            "type": "object",
-            "required": [
-                "end_time",
-                "start_time",
-                "text"
-            ],
-            "properties": {
-                "end_time": {
-                    "description": "Milliseconds, must be \u003e StartTime",
-                    "type": "integer"
                 },
-                "start_time": {
-                    "description": "Milliseconds",
-                    "type": "integer",
-                    "minimum": 0
+                "id": {
+                    "type": "string"
                 },
-                "text": {
-                    "type": "string",
-                    "minLength": 1
+                "is_blocked": {
+                    "type": "boolean"
+                },
+                "user_agent": {
+                    "type": "string"
                 }
             }
         },
-        "dto.CreateTagRequest": {
+        "dto.SetTagParentRequest": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "name": {
+                "parent_id": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 1
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 }
             }
         },
-        "dto.CreateUserRequest": {
+        "dto.SignupRequest": {
             "type": "object",
             "required": [
                 "email",
@@ -2003,421 +5213,574 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.CreateVideoRequest": {
+        "dto.SplitAliasRequest": {
             "type": "object",
-            "required": [
-                "youtube_id"
-            ],
             "properties": {
-                "youtube_id": {
+                "display_name": {
+                    "description": "Defaults to alias raw text",
                     "type": "string",
-                    "maxLength": 11,
-                    "minLength": 11
+                    "maxLength": 100
                 }
             }
         },
-        "dto.ErrorDetail": {
+        "dto.SubmitReviewRequest": {
             "type": "object",
             "properties": {
-                "code": {
-                    "description": "Machine-readable error code (e.g., \"TAG_DUPLICATE\")",
+                "notes": {
+                    "description": "Optional: Add any review metadata if needed in future",
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "dto.TagAliasResponse": {
+            "type": "object",
+            "properties": {
+                "canonical_tag_id": {
                     "type": "string"
                 },
-                "details": {
-                    "description": "Additional context (e.g., suggestions)"
+                "created_at": {
+                    "type": "string"
                 },
-                "field": {
-                    "description": "Field name for validation errors",
+                "id": {
                     "type": "string"
                 },
-                "message": {
-                    "description": "Human-readable error message",
+                "is_reviewed": {
+                    "description": "false = AI auto-mapped",
+                    "type": "boolean"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "normalized_text": {
+                    "type": "string"
+                },
+                "raw_text": {
                     "type": "string"
+                },
+                "similarity_score": {
+                    "description": "0.0 to 1.0 (1.0 = exact/initial alias)",
+                    "type": "number"
                 }
             }
         },
-        "dto.ErrorResponse": {
+        "dto.TagBatchResolution": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer"
+                "decision": {
+                    "description": "exact_match|translation_match|auto_merge|create_new_suggested|create_new|create_new_no_embedding",
+                    "type": "string"
                 },
                 "error": {
+                    "description": "Set when the input could not be resolved",
                     "type": "string"
                 },
-                "message": {
+                "input": {
+                    "type": "string"
+                },
+                "is_new": {
+                    "description": "A new canonical tag was created",
+                    "type": "boolean"
+                },
+                "layer": {
+                    "description": "\"1\" | \"1.5\" | \"3\" | \"4\"",
+                    "type": "string"
+                },
+                "link_status": {
+                    "description": "added|already_tagged when video_id is set",
+                    "type": "string"
+                },
+                "normalized_text": {
+                    "type": "string"
+                },
+                "tag": {
+                    "$ref": "#/definitions/dto.TagResponse"
+                },
+                "translated_text": {
+                    "description": "Layer 1.5 output (nil if skipped/failed)",
                     "type": "string"
                 }
             }
         },
-        "dto.GoogleAuthURLResponse": {
+        "dto.TagBySlugResponse": {
             "type": "object",
             "properties": {
-                "url": {
-                    "type": "string"
+                "redirected": {
+                    "description": "true if the requested slug is historic; use tag.slug",
+                    "type": "boolean"
+                },
+                "tag": {
+                    "$ref": "#/definitions/dto.TagResponse"
                 }
             }
         },
-        "dto.LoginRequest": {
+        "dto.TagDuplicateCandidateResponse": {
             "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
             "properties": {
-                "password": {
+                "co_occurrence_count": {
+                    "type": "integer"
+                },
+                "co_occurring_videos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VideoRefResponse"
+                    }
+                },
+                "detected_at": {
                     "type": "string"
                 },
-                "username": {
+                "embedding_distance": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "matched_aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "score": {
+                    "type": "number"
+                },
+                "slug_similarity": {
+                    "type": "number"
+                },
+                "source": {
+                    "description": "scan | resolve",
                     "type": "string"
+                },
+                "tag_a": {
+                    "$ref": "#/definitions/dto.TagResponse"
+                },
+                "tag_b": {
+                    "$ref": "#/definitions/dto.TagResponse"
+                },
+                "trigram_similarity": {
+                    "type": "number"
                 }
             }
         },
-        "dto.MergeTagsRequest": {
+        "dto.TagDuplicateListResponse": {
             "type": "object",
-            "required": [
-                "source_id",
-                "target_id"
-            ],
             "properties": {
-                "source_id": {
-                    "description": "Tag to be merged (will become alias)",
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagDuplicateCandidateResponse"
+                    }
                 },
-                "target_id": {
-                    "description": "Target canonical tag (will remain)",
-                    "type": "string"
+                "pagination": {
+                    "$ref": "#/definitions/dto.PaginationMetadata"
                 }
             }
         },
-        "dto.Metadata": {
+        "dto.TagListResponse": {
             "type": "object",
             "properties": {
-                "auto_merged": {
-                    "description": "true if auto-merged with existing",
-                    "type": "boolean"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagResponse"
+                    }
                 },
-                "canonical_name": {
-                    "description": "Canonical/normalized name",
+                "pagination": {
+                    "$ref": "#/definitions/dto.PaginationMetadata"
+                }
+            }
+        },
+        "dto.TagProposalListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagProposalResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/dto.PaginationMetadata"
+                }
+            }
+        },
+        "dto.TagProposalResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
                     "type": "string"
                 },
-                "is_new_resource": {
-                    "description": "Tag operation metadata",
-                    "type": "boolean"
+                "id": {
+                    "type": "string"
                 },
-                "layer_hit": {
-                    "description": "Which resolution layer matched (1/1.5/2/3/4)",
-                    "type": "integer"
+                "proposer_id": {
+                    "type": "string"
                 },
-                "matched_via": {
-                    "description": "How match was found: \"exact\"|\"translation\"|\"vector\"|\"new\"",
+                "proposer_username": {
                     "type": "string"
                 },
-                "merged_into": {
-                    "description": "ID of resource merged into",
+                "rejection_reason": {
+                    "description": "rejected only",
                     "type": "string"
                 },
-                "original_input": {
-                    "description": "User's original input",
+                "reviewed_at": {
                     "type": "string"
                 },
-                "pagination": {
-                    "description": "Pagination metadata (for list endpoints)",
+                "reviewed_by": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "pending, approved, rejected",
+                    "type": "string"
+                },
+                "tag": {
+                    "description": "Linked canonical tag (approved only)",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/dto.PaginationMetadata"
+                            "$ref": "#/definitions/dto.TagResponse"
                         }
                     ]
                 },
-                "processing_time_ms": {
-                    "description": "Performance metadata",
-                    "type": "integer"
-                },
-                "similarity_score": {
-                    "description": "Vector similarity score (0.0-1.0)",
-                    "type": "number"
-                },
-                "translated_from": {
-                    "description": "Original language input",
-                    "type": "string"
-                },
-                "translated_to": {
-                    "description": "Translated English term",
+                "tag_name": {
                     "type": "string"
                 },
-                "translation_used": {
-                    "description": "true if Translation Layer was used",
-                    "type": "boolean"
+                "video": {
+                    "description": "nil if the video was deleted",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.VideoRefResponse"
+                        }
+                    ]
                 }
             }
         },
-        "dto.ModVideoListResponse": {
+        "dto.TagResolveLayerTrace": {
             "type": "object",
             "properties": {
-                "page": {
-                    "type": "integer"
-                },
-                "page_size": {
-                    "type": "integer"
+                "detail": {
+                    "description": "Explanation for mods",
+                    "type": "string"
                 },
-                "total": {
-                    "type": "integer"
+                "layer": {
+                    "description": "\"1\" | \"1.5\" | \"2\" | \"3\" | \"4\"",
+                    "type": "string"
                 },
-                "videos": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.ModVideoResponse"
-                    }
+                "name": {
+                    "description": "Human-readable layer name",
+                    "type": "string"
+                },
+                "result": {
+                    "description": "hit | miss | skipped | error | decision",
+                    "type": "string"
                 }
             }
         },
-        "dto.ModVideoResponse": {
+        "dto.TagResolveTraceResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "description": {
+                "decision": {
+                    "description": "exact_match|translation_match|auto_merge|create_new_suggested|create_new|create_new_no_embedding|unavailable",
                     "type": "string"
                 },
-                "duration": {
-                    "type": "integer"
+                "decision_tag": {
+                    "description": "Existing canonical that would be used (nil if a new one would be created)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    ]
                 },
-                "has_transcript": {
+                "dry_run": {
                     "type": "boolean"
                 },
-                "id": {
-                    "type": "string"
-                },
-                "published_at": {
+                "input": {
                     "type": "string"
                 },
-                "review_count": {
-                    "description": "Number of reviews",
-                    "type": "integer"
-                },
-                "tags": {
+                "layers": {
+                    "description": "Step-by-step reasoning",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.TagResponse"
+                        "$ref": "#/definitions/dto.TagResolveLayerTrace"
                     }
                 },
-                "thumbnail_url": {
+                "match_strategy": {
+                    "description": "How Layer 3 measures the distance to a canonical",
                     "type": "string"
                 },
-                "title": {
-                    "type": "string"
+                "nearest_aliases": {
+                    "description": "Top-K aliases by vector distance",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.NearestAliasResponse"
+                    }
                 },
-                "updated_at": {
+                "normalized_text": {
                     "type": "string"
                 },
-                "view_count": {
-                    "type": "integer"
+                "threshold": {
+                    "description": "Distance threshold applied in Layer 3",
+                    "type": "number"
                 },
-                "youtube_id": {
+                "translated_text": {
+                    "description": "Layer 1.5 output (nil if skipped/failed)",
                     "type": "string"
                 }
             }
         },
-        "dto.PaginationMetadata": {
+        "dto.TagResponse": {
             "type": "object",
             "properties": {
-                "limit": {
+                "alias_count": {
+                    "description": "Aliases pointing to this tag",
                     "type": "integer"
                 },
-                "page": {
-                    "type": "integer"
+                "aliases": {
+                    "description": "List of alias names for display",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "total_items": {
-                    "type": "integer"
+                "aliases_by_language": {
+                    "description": "Same aliases keyed by language (vi, en, ..., unk)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
                 },
-                "total_pages": {
+                "display_names": {
+                    "description": "Localized names by locale (single tag responses)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_approved": {
+                    "type": "boolean"
+                },
+                "locale": {
+                    "description": "Locale of name (\"\" = default display name)",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "Parent in the topic tree (nil = root)",
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "video_count": {
+                    "description": "Videos tagged with this tag",
                     "type": "integer"
                 }
             }
         },
-        "dto.ResponseStatus": {
-            "type": "string",
-            "enum": [
-                "success",
-                "created",
-                "merged",
-                "error",
-                "not_found",
-                "conflict",
-                "invalid"
-            ],
-            "x-enum-comments": {
-                "StatusConflict": "Business logic conflict (HTTP 409)",
-                "StatusCreated": "New resource created (HTTP 201)",
-                "StatusError": "Generic error",
-                "StatusInvalid": "Invalid input (HTTP 400)",
-                "StatusMerged": "Resource auto-merged with existing (tag deduplication)",
-                "StatusNotFound": "Resource not found (HTTP 404)",
-                "StatusSuccess": "Operation completed successfully"
-            },
-            "x-enum-descriptions": [
-                "Operation completed successfully",
-                "New resource created (HTTP 201)",
-                "Resource auto-merged with existing (tag deduplication)",
-                "Generic error",
-                "Resource not found (HTTP 404)",
-                "Business logic conflict (HTTP 409)",
-                "Invalid input (HTTP 400)"
-            ],
-            "x-enum-varnames": [
-                "StatusSuccess",
-                "StatusCreated",
-                "StatusMerged",
-                "StatusError",
-                "StatusNotFound",
-                "StatusConflict",
-                "StatusInvalid"
-            ]
-        },
-        "dto.SegmentResponse": {
+        "dto.TagSearchResponse": {
             "type": "object",
             "properties": {
-                "end_time": {
-                    "description": "Milliseconds",
-                    "type": "integer"
+                "query": {
+                    "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagSearchResult"
+                    }
                 },
-                "start_time": {
-                    "description": "Milliseconds",
+                "total": {
                     "type": "integer"
-                },
-                "text": {
-                    "type": "string"
                 }
             }
         },
-        "dto.SessionResponse": {
+        "dto.TagSearchResult": {
             "type": "object",
             "properties": {
-                "client_ip": {
+                "id": {
                     "type": "string"
                 },
-                "created_at": {
+                "name": {
                     "type": "string"
                 },
-                "expires_at": {
-                    "type": "string"
+                "similarity": {
+                    "description": "Cosine similarity (0-1)",
+                    "type": "number"
+                }
+            }
+        },
+        "dto.TagTreeNodeResponse": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagTreeNodeResponse"
+                    }
                 },
                 "id": {
                     "type": "string"
                 },
-                "is_blocked": {
+                "is_approved": {
                     "type": "boolean"
                 },
-                "user_agent": {
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
                     "type": "string"
+                },
+                "total_video_count": {
+                    "description": "Distinct videos in the whole subtree",
+                    "type": "integer"
+                },
+                "video_count": {
+                    "description": "Videos tagged directly",
+                    "type": "integer"
                 }
             }
         },
-        "dto.SignupRequest": {
+        "dto.TaxonomyAliasItem": {
             "type": "object",
-            "required": [
-                "email",
-                "password",
-                "username"
-            ],
             "properties": {
-                "email": {
-                    "type": "string",
-                    "maxLength": 100
+                "embedding": {
+                    "description": "Optional; regenerated on import when missing",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
                 },
-                "full_name": {
-                    "type": "string",
-                    "maxLength": 100
+                "is_reviewed": {
+                    "type": "boolean"
                 },
-                "password": {
+                "language": {
                     "type": "string",
-                    "minLength": 6
+                    "example": "vi"
                 },
-                "username": {
+                "text": {
                     "type": "string",
-                    "maxLength": 50,
-                    "minLength": 3
+                    "example": "Học máy"
                 }
             }
         },
-        "dto.SubmitReviewRequest": {
+        "dto.TaxonomyConflictResponse": {
             "type": "object",
             "properties": {
-                "notes": {
-                    "description": "Optional: Add any review metadata if needed in future",
+                "alias": {
+                    "type": "string"
+                },
+                "existing_slug": {
+                    "description": "Current owner of the alias / missing parent",
+                    "type": "string"
+                },
+                "kind": {
                     "type": "string",
-                    "maxLength": 500
+                    "example": "alias_elsewhere"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
                 }
             }
         },
-        "dto.TagListResponse": {
+        "dto.TaxonomyFile": {
             "type": "object",
+            "required": [
+                "format",
+                "version"
+            ],
             "properties": {
-                "data": {
+                "exported_at": {
+                    "type": "string"
+                },
+                "format": {
+                    "type": "string",
+                    "example": "ttt-tag-taxonomy"
+                },
+                "tags": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.TagResponse"
+                        "$ref": "#/definitions/dto.TaxonomyTagItem"
                     }
                 },
-                "pagination": {
-                    "$ref": "#/definitions/dto.PaginationMetadata"
+                "version": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "dto.TagResponse": {
+        "dto.TaxonomyImportResponse": {
             "type": "object",
             "properties": {
-                "aliases": {
-                    "description": "List of alias names for display",
+                "aliases_created": {
+                    "type": "integer"
+                },
+                "aliases_unchanged": {
+                    "type": "integer"
+                },
+                "conflicts": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dto.TaxonomyConflictResponse"
                     }
                 },
-                "id": {
-                    "type": "string"
-                },
-                "is_approved": {
+                "dry_run": {
                     "type": "boolean"
                 },
-                "name": {
-                    "type": "string"
+                "embeddings_generated": {
+                    "type": "integer"
+                },
+                "embeddings_missing": {
+                    "description": "Aliases left without embedding (excluded from semantic search)",
+                    "type": "integer"
+                },
+                "parents_set": {
+                    "type": "integer"
+                },
+                "tags_created": {
+                    "type": "integer"
+                },
+                "tags_unchanged": {
+                    "type": "integer"
+                },
+                "tags_updated": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.TagSearchResponse": {
+        "dto.TaxonomyTagItem": {
             "type": "object",
             "properties": {
-                "query": {
-                    "type": "string"
-                },
-                "results": {
+                "aliases": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.TagSearchResult"
+                        "$ref": "#/definitions/dto.TaxonomyAliasItem"
                     }
                 },
-                "total": {
-                    "type": "integer"
-                }
-            }
-        },
-        "dto.TagSearchResult": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string"
+                "display_name": {
+                    "type": "string",
+                    "example": "Machine Learning"
                 },
-                "name": {
-                    "type": "string"
+                "is_approved": {
+                    "type": "boolean"
+                },
+                "parent_slug": {
+                    "description": "Empty = root",
+                    "type": "string",
+                    "example": "artificial-intelligence"
                 },
-                "similarity": {
-                    "description": "Cosine similarity (0-1)",
-                    "type": "number"
+                "slug": {
+                    "type": "string",
+                    "example": "machine-learning"
                 }
             }
         },
@@ -2479,6 +5842,114 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.TrashedVideoListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TrashedVideoResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/dto.PaginationMetadata"
+                }
+            }
+        },
+        "dto.TrashedVideoResponse": {
+            "type": "object",
+            "properties": {
+                "deleted_at": {
+                    "type": "string"
+                },
+                "duration": {
+                    "description": "Seconds - frontend converts to mm:ss",
+                    "type": "integer"
+                },
+                "has_transcript": {
+                    "description": "Show \"CC\" badge",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "review_count": {
+                    "description": "Number of reviews - show \"Đã duyệt\" badge if \u003e 0",
+                    "type": "integer"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "view_count": {
+                    "type": "integer"
+                },
+                "youtube_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TrendingTagResponse": {
+            "type": "object",
+            "properties": {
+                "recent_video_count": {
+                    "description": "Videos tagged within the window",
+                    "type": "integer"
+                },
+                "tag": {
+                    "$ref": "#/definitions/dto.TagResponse"
+                }
+            }
+        },
+        "dto.UndoMergeResponse": {
+            "type": "object",
+            "properties": {
+                "merge_id": {
+                    "type": "integer"
+                },
+                "removed_target_links": {
+                    "description": "Target links that only existed because of the merge",
+                    "type": "integer"
+                },
+                "restored_alias_count": {
+                    "description": "Aliases moved back to source",
+                    "type": "integer"
+                },
+                "restored_tag": {
+                    "description": "Source tag, recreated with its original ID",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    ]
+                },
+                "restored_video_count": {
+                    "description": "Video links restored on source",
+                    "type": "integer"
+                },
+                "target_tag": {
+                    "description": "Tag the source had been merged into",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "dto.UpdateAliasReviewRequest": {
+            "type": "object",
+            "properties": {
+                "is_reviewed": {
+                    "type": "boolean"
+                }
+            }
+        },
         "dto.UpdateMeRequest": {
             "type": "object",
             "properties": {
@@ -2492,6 +5963,52 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UpdateResolutionPolicyRequest": {
+            "type": "object",
+            "properties": {
+                "auto_approve_aliases": {
+                    "description": "AI-mapped aliases skip the review queue",
+                    "type": "boolean"
+                },
+                "auto_merge_distance": {
+                    "description": "Cosine distance (0, 2]; 0.40 = 80% similarity",
+                    "type": "number",
+                    "example": 0.4
+                },
+                "create_without_embedding": {
+                    "description": "Create canonicals when OpenAI is unavailable",
+                    "type": "boolean"
+                },
+                "language_distances": {
+                    "description": "Per-language override, replaces all overrides ({} clears)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                },
+                "match_strategy": {
+                    "description": "alias_min | centroid | hybrid",
+                    "type": "string",
+                    "example": "hybrid"
+                },
+                "merge_mode": {
+                    "description": "auto_merge | suggest",
+                    "type": "string",
+                    "example": "auto_merge"
+                },
+                "reason": {
+                    "description": "Stored in the audit trail",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Too many wrong merges"
+                },
+                "translation_enabled": {
+                    "description": "Layer 1.5 on/off",
+                    "type": "boolean"
+                }
+            }
+        },
         "dto.UpdateSegmentRequest": {
             "type": "object",
             "required": [
@@ -2539,6 +6056,43 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UpdateVideoRequest": {
+            "type": "object",
+            "required": [
+                "updated_at"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "duration": {
+                    "description": "Seconds",
+                    "type": "integer"
+                },
+                "published_at": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string",
+                    "example": "2024-05-01"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "unlock_fields": {
+                    "description": "Let the refresh overwrite these fields again",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "description": "updated_at from the last read; rejected with 409 if the video changed since",
+                    "type": "string"
+                }
+            }
+        },
         "dto.UserListResponse": {
             "type": "object",
             "properties": {
@@ -2620,12 +6174,40 @@ const docTemplate = `{
         "dto.VideoCreateResponse": {
             "type": "object",
             "properties": {
+                "category_id": {
+                    "description": "YouTube category ID (27 = Education)",
+                    "type": "string"
+                },
+                "channel_id": {
+                    "type": "string"
+                },
+                "channel_title": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
+                "default_audio_language": {
+                    "description": "BCP-47, set by the channel",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
                 "duration": {
                     "type": "integer"
                 },
+                "existing_deleted": {
+                    "type": "boolean"
+                },
+                "existing_video_id": {
+                    "description": "Preview only: the video is already stored (deleted = soft-deleted, restore instead of creating)",
+                    "type": "string"
+                },
+                "has_captions": {
+                    "description": "Captions exist on YouTube",
+                    "type": "boolean"
+                },
                 "has_transcript": {
                     "type": "boolean"
                 },
@@ -2652,10 +6234,31 @@ const docTemplate = `{
         "dto.VideoDetailResponse": {
             "type": "object",
             "properties": {
+                "category_id": {
+                    "description": "YouTube category ID (27 = Education)",
+                    "type": "string"
+                },
+                "channel_id": {
+                    "type": "string"
+                },
+                "channel_title": {
+                    "type": "string"
+                },
+                "default_audio_language": {
+                    "description": "BCP-47, set by the channel",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
                 "duration": {
                     "description": "Seconds - frontend converts to mm:ss",
                     "type": "integer"
                 },
+                "has_captions": {
+                    "description": "Captions exist on YouTube",
+                    "type": "boolean"
+                },
                 "has_transcript": {
                     "description": "Show \"CC\" badge",
                     "type": "boolean"
@@ -2690,6 +6293,110 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.VideoDuplicateResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "deleted": {
+                    "description": "Soft-deleted: restore instead of creating",
+                    "type": "boolean"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "existing_video": {
+                    "$ref": "#/definitions/dto.VideoCardResponse"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "restore_path": {
+                    "description": "POST to restore (deleted only)",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.VideoImportJobListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VideoImportJobResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/dto.PaginationMetadata"
+                }
+            }
+        },
+        "dto.VideoImportJobResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "description": "Fatal error that stopped the job",
+                    "type": "string"
+                },
+                "errors": {
+                    "description": "Per-video errors (first 100)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "found": {
+                    "description": "IDs read from the source so far",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "processed": {
+                    "description": "created + skipped + failed",
+                    "type": "integer"
+                },
+                "requested_by": {
+                    "type": "string"
+                },
+                "skipped": {
+                    "description": "Already in the database",
+                    "type": "integer"
+                },
+                "source": {
+                    "description": "channel | playlist | urls",
+                    "type": "string"
+                },
+                "source_id": {
+                    "description": "Channel or playlist ID",
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "queued | running | completed | failed",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Expected number of videos (0 until known)",
+                    "type": "integer"
+                }
+            }
+        },
         "dto.VideoListResponse": {
             "type": "object",
             "properties": {
@@ -2704,6 +6411,20 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.VideoRefResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "youtube_id": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.VideoTranscriptReviewResponse": {
             "type": "object",
             "properties": {
@@ -22,6 +22,24 @@ var (
 	ErrSourceTagNotFound    = errors.New("source tag not found")
 	ErrTargetTagNotFound    = errors.New("target tag not found")
 	ErrSameSourceTarget     = errors.New("source and target tags must be different")
+	ErrAliasNotFound        = errors.New("tag alias not found")
+	ErrLastAlias            = errors.New("cannot remove the last alias of a canonical tag")
+	ErrSlugTaken            = errors.New("slug already used by another canonical tag")
+	ErrDuplicateNotFound    = errors.New("duplicate candidate not found")
+	ErrDuplicateResolved    = errors.New("duplicate candidate already resolved")
+	ErrMergeLogNotFound     = errors.New("merge log not found")
+	ErrMergeAlreadyUndone   = errors.New("merge already undone")
+	ErrMergeUndoConflict    = errors.New("merge cannot be undone: tags changed since merge")
+	ErrParentTagNotFound    = errors.New("parent tag not found")
+	ErrTagCycle             = errors.New("parent would create a cycle in the tag hierarchy")
+	ErrAdminRequired        = errors.New("approved tags can only be deleted by an admin")
+	ErrDisplayNameNotFound  = errors.New("display name not found")
+	ErrAliasTaken           = errors.New("alias text already belongs to a canonical tag")
+	ErrProposalNotFound     = errors.New("tag proposal not found")
+	ErrProposalReviewed     = errors.New("tag proposal already reviewed")
+	ErrProposalExists       = errors.New("a pending proposal for this tag already exists on the video")
+	ErrVideoAlreadyTagged   = errors.New("video already has this tag")
+	ErrEmbeddingUnavailable = errors.New("embedding service unavailable and policy forbids creating tags without it")
 )
 
 // CanonicalTag đại diện cho một chủ đề duy nhất (concept)
@@ -34,6 +52,18 @@ type CanonicalTag struct {
 	// Approval status - tags need to be approved by moderator before being visible
 	IsApproved bool `gorm:"default:false"`
 
+	// Parent trong cây chủ đề (nil = node gốc)
+	// Ví dụ: Machine Learning → AI → Technology
+	ParentID *uuid.UUID `gorm:"type:uuid;index"`
+
+	// Bộ đếm sử dụng, cập nhật tăng dần khi gắn/gỡ tag, merge và thao tác alias
+	VideoCount int64 `gorm:"not null;default:0;index"`
+	AliasCount int64 `gorm:"not null;default:0"`
+
+	// Trung bình embedding của các alias (Layer 3 theo chiến lược centroid/hybrid), NULL nếu chưa alias nào có embedding
+	// Chỉ được tính lại bằng SQL khi alias thay đổi, không bao giờ đọc/ghi qua struct
+	Centroid *pgvector.Vector `gorm:"type:vector(1536);->:false"`
+
 	// Has Many Aliases (one-to-many relationship)
 	Aliases []TagAlias `gorm:"foreignKey:CanonicalTagID"`
 
@@ -50,20 +80,333 @@ type TagAlias struct {
 	// User input variants
 	RawText        string `gorm:"type:varchar(100);not null"`
 	NormalizedText string `gorm:"type:varchar(100);not null;uniqueIndex"` // LOWER(TRIM(raw_text))
-	Language       string `gorm:"type:varchar(10);default:'unk'"`
+	Language       string `gorm:"type:varchar(10);default:'unk'"`         // DetectLanguage(raw_text): vi, en, ... or unk
 
 	// Vector Embedding for semantic search (text-embedding-3-small: 1536 dims)
 	Embedding pgvector.Vector `gorm:"type:vector(1536)"`
 
 	// Metadata for admin review
-	IsReviewed      bool    `gorm:"default:false"` // FALSE = AI auto-mapped
-	SimilarityScore float64 `gorm:"type:float;default:1.0"`
+	IsReviewed      bool       `gorm:"default:false"` // FALSE = AI auto-mapped
+	SimilarityScore float64    `gorm:"type:float;default:1.0"`
+	ReviewedBy      *uuid.UUID `gorm:"type:uuid"` // Mod who last reviewed this alias
+	ReviewedAt      *time.Time
+
+	CreatedAt time.Time
+}
+
+// AliasReviewAction is the action a mod took on an alias from the review queue
+type AliasReviewAction string
+
+const (
+	AliasReviewAccept   AliasReviewAction = "accept"   // Keep alias on its current canonical
+	AliasReviewReassign AliasReviewAction = "reassign" // Move alias to another canonical
+	AliasReviewSplit    AliasReviewAction = "split"    // Detach alias into a new canonical
+)
+
+// TagAliasReviewLog ghi lại mỗi thao tác review alias (dùng cho thống kê năng suất mod)
+type TagAliasReviewLog struct {
+	ID              uint              `gorm:"primaryKey;autoIncrement"`
+	AliasID         uuid.UUID         `gorm:"type:uuid;not null;index"`
+	ReviewerID      uuid.UUID         `gorm:"type:uuid;not null;index"`
+	Action          AliasReviewAction `gorm:"type:varchar(20);not null"`
+	FromCanonicalID uuid.UUID         `gorm:"type:uuid;not null"`
+	ToCanonicalID   uuid.UUID         `gorm:"type:uuid;not null"`
+	CreatedAt       time.Time         `gorm:"index"`
+}
+
+// CanonicalMatch là một canonical tag gần với một embedding (khoảng cách theo MatchStrategy, mặc định nhỏ nhất qua các alias)
+type CanonicalMatch struct {
+	CanonicalTagID uuid.UUID
+	CanonicalName  string
+	Distance       float64
+}
+
+// AliasReviewerStats là số liệu review alias của một mod trong khoảng thời gian
+type AliasReviewerStats struct {
+	ReviewerID     uuid.UUID
+	Username       string
+	Accepted       int64
+	Reassigned     int64
+	Split          int64
+	Total          int64
+	LastReviewedAt time.Time
+}
+
+// DuplicateStatus is the review state of a duplicate candidate pair
+type DuplicateStatus string
+
+const (
+	DuplicateStatusPending   DuplicateStatus = "pending"   // Waiting for mod decision
+	DuplicateStatusAccepted  DuplicateStatus = "accepted"  // Merged via MergeTags
+	DuplicateStatusDismissed DuplicateStatus = "dismissed" // Not a duplicate - never suggested again
+)
+
+// DuplicateSource is what created a duplicate candidate pair
+type DuplicateSource string
+
+const (
+	DuplicateSourceScan    DuplicateSource = "scan"    // Duplicate detection job (refreshed or dropped on every scan)
+	DuplicateSourceResolve DuplicateSource = "resolve" // ResolveTag in suggest mode (kept until reviewed)
+)
+
+// TagDuplicateCandidate là một cặp canonical tag có khả năng trùng lặp (do job phát hiện hoặc ResolveTag gợi ý)
+// Cặp luôn được lưu với TagAID < TagBID để (A, B) và (B, A) là một
+type TagDuplicateCandidate struct {
+	ID     uint            `gorm:"primaryKey;autoIncrement"`
+	TagAID uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_tag_duplicate_pair"`
+	TagBID uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_tag_duplicate_pair"`
+	Status DuplicateStatus `gorm:"type:varchar(20);not null;default:'pending';index"`
+	Source DuplicateSource `gorm:"type:varchar(20);not null;default:'scan'"`
+
+	// Scores (0-1, higher = more likely duplicate)
+	Score             float64  `gorm:"type:float;not null;index"`
+	EmbeddingDistance *float64 `gorm:"type:float"` // nil when no alias pair is close enough
+	SlugSimilarity    float64  `gorm:"type:float"`
+	TrigramSimilarity float64  `gorm:"type:float"`
+
+	// Evidence
+	MatchedAliasA     string `gorm:"type:varchar(100)"` // Closest alias of A
+	MatchedAliasB     string `gorm:"type:varchar(100)"` // Closest alias of B
+	CoOccurrenceCount int64  // Videos tagged with both A and B
+
+	ReviewedBy *uuid.UUID `gorm:"type:uuid"`
+	ReviewedAt *time.Time
 
 	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// DuplicatePair là kết quả thô của truy vấn phát hiện trùng lặp (chưa tính điểm)
+type DuplicatePair struct {
+	TagAID            uuid.UUID
+	TagBID            uuid.UUID
+	SlugA             string
+	SlugB             string
+	AliasA            string
+	AliasB            string
+	EmbeddingDistance *float64
+	TrigramSimilarity float64
+	CoOccurrenceCount int64
+}
+
+// TagRelation là một tag liên quan ("xem thêm") của một canonical tag, do job tính định kỳ
+// Lưu theo cả hai chiều (A → B và B → A) để tra cứu theo TagID
+type TagRelation struct {
+	TagID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	RelatedTagID uuid.UUID `gorm:"type:uuid;primaryKey"`
+
+	// Scores (0-1, higher = more related)
+	Score             float64  `gorm:"type:float;not null;index"`
+	Jaccard           float64  `gorm:"type:float"` // co_videos / (videos_A + videos_B - co_videos)
+	CentroidDistance  *float64 `gorm:"type:float"` // Cosine distance of alias-embedding centroids; nil without embeddings
+	CoOccurrenceCount int64    // Videos tagged with both tags
+
+	UpdatedAt time.Time
+}
+
+// RelatedTagPair là kết quả thô của truy vấn tag liên quan (chưa tính điểm)
+type RelatedTagPair struct {
+	TagID             uuid.UUID
+	RelatedTagID      uuid.UUID
+	CoOccurrenceCount int64
+	Jaccard           float64
+	CentroidDistance  *float64
+}
+
+// RelatedTag là một tag liên quan kèm điểm (dùng để trả về API)
+type RelatedTag struct {
+	Tag      CanonicalTag
+	Relation TagRelation
+}
+
+// TagSlugHistory lưu các slug cũ của canonical tag (sau khi đổi tên) để redirect
+type TagSlugHistory struct {
+	ID             uint      `gorm:"primaryKey;autoIncrement"`
+	CanonicalTagID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Slug           string    `gorm:"type:varchar(100);not null;uniqueIndex"`
+	CreatedAt      time.Time // When the tag moved away from this slug
+}
+
+// TagDisplayName là tên hiển thị của một canonical tag theo ngôn ngữ
+// CanonicalTag.DisplayName vẫn là tên mặc định (dùng khi không có bản địa hóa phù hợp)
+type TagDisplayName struct {
+	CanonicalTagID uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	Locale         string     `gorm:"type:varchar(10);primaryKey"` // ISO 639-1, same codes as TagAlias.Language
+	DisplayName    string     `gorm:"type:varchar(100);not null"`
+	AliasID        *uuid.UUID `gorm:"type:uuid"` // Alias promoted to this name (nil = set by import/other)
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// TagMergeLog lưu snapshot của source tag khi merge để có thể undo (unmerge)
+type TagMergeLog struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	SourceTagID uuid.UUID `gorm:"type:uuid;not null;index"`
+	TargetTagID uuid.UUID `gorm:"type:uuid;not null;index"`
+
+	// Snapshot of the deleted source canonical
+	SourceSlug        string `gorm:"type:varchar(100);not null"`
+	SourceDisplayName string `gorm:"type:varchar(100);not null"`
+	SourceIsApproved  bool
+	SourceCreatedAt   time.Time
+	SourceUpdatedAt   time.Time
+
+	// What the merge touched
+	Aliases []TagMergeLogAlias `gorm:"foreignKey:MergeLogID"`
+	Videos  []TagMergeLogVideo `gorm:"foreignKey:MergeLogID"`
+
+	// Hierarchy changes made by the merge
+	SourceParentID     *uuid.UUID         `gorm:"type:uuid"`
+	TargetMoved        bool               // Target was a descendant of source and was lifted to source's parent
+	TargetPrevParentID *uuid.UUID         `gorm:"type:uuid"`
+	Children           []TagMergeLogChild `gorm:"foreignKey:MergeLogID"` // Children of source re-parented to target
+
+	// Slug history and localized names of the source
+	Slugs        []TagMergeLogSlug        `gorm:"foreignKey:MergeLogID"` // Old slugs of source handed to target
+	DisplayNames []TagMergeLogDisplayName `gorm:"foreignKey:MergeLogID"` // Localized names of source (deleted by the merge)
+
+	MergedBy *uuid.UUID `gorm:"type:uuid"`
+	UndoneBy *uuid.UUID `gorm:"type:uuid"`
+	UndoneAt *time.Time
+
+	CreatedAt time.Time `gorm:"index"`
+}
+
+// TagMergeLogAlias là một alias đã được chuyển từ source sang target
+type TagMergeLogAlias struct {
+	MergeLogID uint      `gorm:"primaryKey"`
+	AliasID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+}
+
+// TagMergeLogVideo là một video đã gắn source tag trước khi merge
+// LinkCreated = true nếu merge tạo mới link (video, target); false nếu link đã tồn tại
+type TagMergeLogVideo struct {
+	MergeLogID  uint      `gorm:"primaryKey"`
+	VideoID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	LinkCreated bool      `gorm:"not null"`
+}
+
+// TagMergeLogChild là một tag con của source đã được chuyển sang target khi merge
+type TagMergeLogChild struct {
+	MergeLogID uint      `gorm:"primaryKey"`
+	ChildID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+}
+
+// TagMergeLogSlug là một slug cũ của source đã được chuyển sang target khi merge
+// Slug hiện tại của source không nằm ở đây (đã có trong TagMergeLog.SourceSlug)
+type TagMergeLogSlug struct {
+	MergeLogID uint   `gorm:"primaryKey"`
+	Slug       string `gorm:"type:varchar(100);primaryKey"`
+}
+
+// TagMergeLogDisplayName là snapshot một tên bản địa hóa của source (bị xóa khi merge)
+type TagMergeLogDisplayName struct {
+	MergeLogID  uint       `gorm:"primaryKey"`
+	Locale      string     `gorm:"type:varchar(10);primaryKey"`
+	DisplayName string     `gorm:"type:varchar(100);not null"`
+	AliasID     *uuid.UUID `gorm:"type:uuid"`
+}
+
+// VideoTagLink là một liên kết video ↔ canonical tag (một dòng của video_canonical_tags)
+type VideoTagLink struct {
+	VideoID        uuid.UUID
+	CanonicalTagID uuid.UUID
+}
+
+// TagSort is the ordering of canonical tag listings
+type TagSort string
+
+const (
+	TagSortName    TagSort = "name"    // display_name A-Z (default)
+	TagSortPopular TagSort = "popular" // Most tagged videos first
+	TagSortGrowing TagSort = "growing" // Most videos tagged within the recent window first
+)
+
+// TrendingTag là canonical tag kèm số video được gắn tag trong khoảng thời gian gần đây
+type TrendingTag struct {
+	Tag              CanonicalTag
+	RecentVideoCount int64
+}
+
+// TagTreeNode là một node trong cây chủ đề kèm số video
+type TagTreeNode struct {
+	ID              uuid.UUID
+	ParentID        *uuid.UUID
+	Slug            string
+	DisplayName     string
+	IsApproved      bool
+	VideoCount      int64 // Videos tagged directly with this tag
+	TotalVideoCount int64 // Distinct videos tagged with this tag or any descendant
 }
 
-func (CanonicalTag) TableName() string { return "canonical_tags" }
-func (TagAlias) TableName() string     { return "tag_aliases" }
+// MovedAliasCount returns the number of aliases moved by the merge
+func (m *TagMergeLog) MovedAliasCount() int {
+	return len(m.Aliases)
+}
+
+// AliasMatch là một alias gần nhất với embedding đầu vào (kết quả vector search)
+// Dùng để giải thích quyết định của Layer 3 (dry-run) mà không ghi gì vào DB
+type AliasMatch struct {
+	AliasID        uuid.UUID
+	CanonicalTagID uuid.UUID
+	CanonicalName  string
+	RawText        string
+	Distance       float64 // cosine distance [0, 2]
+}
+
+// ResolveDecision describes which branch of ResolveTag produced (or would produce) the result
+type ResolveDecision string
+
+const (
+	ResolveDecisionExactMatch       ResolveDecision = "exact_match"             // Layer 1 hit
+	ResolveDecisionTranslationMatch ResolveDecision = "translation_match"       // Layer 1.5 hit
+	ResolveDecisionAutoMerge        ResolveDecision = "auto_merge"              // Layer 3 hit → new alias on existing canonical
+	ResolveDecisionCreateNew        ResolveDecision = "create_new"              // Layer 3 miss → new canonical
+	ResolveDecisionCreateNoEmbed    ResolveDecision = "create_new_no_embedding" // Layer 2 failed → new canonical without semantic check
+	ResolveDecisionSuggestMerge     ResolveDecision = "create_new_suggested"    // Layer 3 hit in suggest mode → new canonical + duplicate suggestion
+	ResolveDecisionUnavailable      ResolveDecision = "unavailable"             // Layer 2 failed and the policy forbids creating without embedding
+)
+
+// CreatesTag reports whether the decision created a new canonical tag
+func (d ResolveDecision) CreatesTag() bool {
+	return d == ResolveDecisionCreateNew || d == ResolveDecisionCreateNoEmbed || d == ResolveDecisionSuggestMerge
+}
+
+// Layer returns the resolution layer that produced the decision ("1", "1.5", "2", "3" or "4")
+func (d ResolveDecision) Layer() string {
+	switch d {
+	case ResolveDecisionExactMatch:
+		return "1"
+	case ResolveDecisionTranslationMatch:
+		return "1.5"
+	case ResolveDecisionUnavailable:
+		return "2"
+	case ResolveDecisionAutoMerge, ResolveDecisionSuggestMerge:
+		return "3"
+	default:
+		return "4"
+	}
+}
+
+// DistanceToSimilarity converts pgvector cosine distance to a 0-1 similarity score
+// Example: distance=0.3 → similarity=0.85
+func DistanceToSimilarity(distance float64) float64 {
+	return 1.0 - (distance / 2.0)
+}
+
+func (CanonicalTag) TableName() string           { return "canonical_tags" }
+func (TagAlias) TableName() string               { return "tag_aliases" }
+func (TagAliasReviewLog) TableName() string      { return "tag_alias_review_logs" }
+func (TagDuplicateCandidate) TableName() string  { return "tag_duplicate_candidates" }
+func (TagMergeLog) TableName() string            { return "tag_merge_logs" }
+func (TagMergeLogAlias) TableName() string       { return "tag_merge_log_aliases" }
+func (TagMergeLogVideo) TableName() string       { return "tag_merge_log_videos" }
+func (TagMergeLogChild) TableName() string       { return "tag_merge_log_children" }
+func (TagMergeLogSlug) TableName() string        { return "tag_merge_log_slugs" }
+func (TagMergeLogDisplayName) TableName() string { return "tag_merge_log_display_names" }
+func (TagSlugHistory) TableName() string         { return "tag_slug_history" }
+func (TagRelation) TableName() string            { return "tag_relations" }
+func (TagDisplayName) TableName() string         { return "tag_display_names" }
 
 // ============================================================
 
@@ -107,6 +450,33 @@ func generateSlug(displayName string) string {
 	return generated
 }
 
+// MaxSlugSuffix is the highest collision suffix tried by SlugCandidates ("-50" fits the reserved 5 chars)
+const MaxSlugSuffix = 50
+
+// SlugCandidates returns the slug for displayName followed by its collision variants
+// Example: ("AI", 3) → ["ai", "ai-2", "ai-3"]
+// Returns error if no valid slug can be generated
+func SlugCandidates(displayName string, max int) ([]string, error) {
+	base := generateSlug(displayName)
+	if base == "" {
+		return nil, fmt.Errorf("failed to generate valid slug from displayName: %s", displayName)
+	}
+
+	candidates := make([]string, 0, max)
+	candidates = append(candidates, base)
+	for n := 2; n <= max; n++ {
+		candidates = append(candidates, fmt.Sprintf("%s-%d", base, n))
+	}
+	return candidates, nil
+}
+
+// IsValidSlug reports whether s could have been produced by generateSlug (plus a collision suffix)
+// Example: "money-finance" → true, "Money Finance" → false
+func IsValidSlug(s string) bool {
+	const maxSlugLength = 100 // DB constraint varchar(100)
+	return len(s) <= maxSlugLength && slug.IsSlug(s)
+}
+
 // NewCanonicalTag creates a new canonical tag with auto-generated slug
 // Returns error if displayName is empty or slug generation fails
 func NewCanonicalTag(displayName string) (*CanonicalTag, error) {
@@ -142,7 +512,7 @@ func NewTagAlias(rawText string, canonicalTagID uuid.UUID, embedding pgvector.Ve
 		NormalizedText:  NormalizeText(rawText),
 		Embedding:       embedding,
 		SimilarityScore: similarityScore,
-		Language:        "unk", // TODO: Detect language using external library
+		Language:        DetectLanguage(rawText),
 	}, nil
 }
 
@@ -160,6 +530,6 @@ func NewInitialTagAlias(rawText string, embedding pgvector.Vector, similaritySco
 		NormalizedText:  NormalizeText(rawText),
 		Embedding:       embedding,
 		SimilarityScore: similarityScore,
-		Language:        "unk",
+		Language:        DetectLanguage(rawText),
 	}, nil
 }
@@ -0,0 +1,251 @@
+package domain
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Alias languages (ISO 639-1, "unk" = not identified)
+const (
+	LanguageVietnamese = "vi"
+	LanguageEnglish    = "en"
+	LanguageChinese    = "zh"
+	LanguageJapanese   = "ja"
+	LanguageKorean     = "ko"
+	LanguageThai       = "th"
+	LanguageUnknown    = "unk"
+)
+
+// Minimum average log-probability gap per trigram for the n-gram model to decide
+const languageMinMargin = 0.35
+
+// vietnameseFold maps every Vietnamese letter with diacritics to its ASCII base letter
+var vietnameseFold = func() map[rune]rune {
+	groups := map[rune]string{
+		'a': "àáảãạăằắẳẵặâầấẩẫậ",
+		'e': "èéẻẽẹêềếểễệ",
+		'i': "ìíỉĩị",
+		'o': "òóỏõọôồốổỗộơờớởỡợ",
+		'u': "ùúủũụưừứửữự",
+		'y': "ỳýỷỹỵ",
+		'd': "đ",
+	}
+	fold := make(map[rune]rune)
+	for base, letters := range groups {
+		for _, r := range letters {
+			fold[r] = base
+		}
+	}
+	return fold
+}()
+
+// DetectLanguage identifies the language of a tag alias
+// 0. All-caps acronyms (AI, SQL) are ignored
+// 1. Script: Hangul → ko, Kana → ja, Han → zh, Thai → th, other non-Latin → unk
+// 2. Vietnamese-only letters (đ, ă, ơ, ư, ạ, ễ, ...) → vi
+// 3. Words that cannot be a Vietnamese syllable (f, j, w, z, clusters, "-ks", "-ld", ...) → en
+// 4. Character trigram model (vi without diacritics vs en), "unk" when too close to call
+func DetectLanguage(text string) string {
+	// Acronyms carry no language signal
+	var kept []string
+	for _, w := range strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsLetter(r) }) {
+		if !isAcronym(w) {
+			kept = append(kept, w)
+		}
+	}
+	text = strings.ToLower(strings.Join(kept, " "))
+	if text == "" {
+		return LanguageUnknown
+	}
+
+	var latin, hangul, kana, han, thai, other, vietnameseOnly, sharedAccents int
+	for _, r := range text {
+		switch {
+		case !unicode.IsLetter(r):
+			continue
+		case unicode.Is(unicode.Hangul, r):
+			hangul++
+		case unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r):
+			kana++
+		case unicode.Is(unicode.Han, r):
+			han++
+		case unicode.Is(unicode.Thai, r):
+			thai++
+		case unicode.Is(unicode.Latin, r):
+			latin++
+			if _, ok := vietnameseFold[r]; ok {
+				if isVietnameseOnlyLetter(r) {
+					vietnameseOnly++
+				} else {
+					sharedAccents++ // à, é, ô, ... also used by other Latin languages
+				}
+			}
+		default:
+			other++
+		}
+	}
+
+	switch {
+	case hangul > 0:
+		return LanguageKorean
+	case kana > 0:
+		return LanguageJapanese // Japanese text mixes kana with kanji
+	case han > 0:
+		return LanguageChinese
+	case thai > 0:
+		return LanguageThai
+	case latin == 0 || other > 0:
+		return LanguageUnknown
+	case vietnameseOnly > 0:
+		return LanguageVietnamese
+	}
+
+	words := strings.Fields(foldVietnamese(text))
+	if sharedAccents == 0 {
+		for _, w := range words {
+			if !isVietnameseSyllable(w) {
+				return LanguageEnglish
+			}
+		}
+	}
+
+	vi, en, n := languageModel().score(words)
+	if n == 0 {
+		return LanguageUnknown
+	}
+	if sharedAccents > 0 {
+		// Accents like "á" or "ô" alone are common in Vietnamese typed without full tones
+		vi += languageMinMargin * float64(n)
+	}
+
+	switch margin := (vi - en) / float64(n); {
+	case margin >= languageMinMargin:
+		return LanguageVietnamese
+	case margin <= -languageMinMargin:
+		return LanguageEnglish
+	default:
+		return LanguageUnknown
+	}
+}
+
+// isVietnameseOnlyLetter reports letters that (almost) only Vietnamese uses:
+// đ, ă, ơ, ư and every vowel with a hook, dot below or tone on a circumflex/breve
+func isVietnameseOnlyLetter(r rune) bool {
+	switch r {
+	case 'đ', 'ă', 'ơ', 'ư', 'ĩ', 'ũ':
+		return true
+	}
+	return r >= 0x1EA0 && r <= 0x1EF9 // Latin Extended Additional (Vietnamese block)
+}
+
+// foldVietnamese removes Vietnamese diacritics ("học máy" → "hoc may")
+func foldVietnamese(text string) string {
+	return strings.Map(func(r rune) rune {
+		if base, ok := vietnameseFold[r]; ok {
+			return base
+		}
+		return r
+	}, text)
+}
+
+// vietnameseSyllable matches a Vietnamese syllable without diacritics: onset + vowels + final
+// Vietnamese has no f, j, w, z, no consonant clusters and only ends in a vowel, c, ch, m, n, ng, nh, p or t
+var vietnameseSyllable = regexp.MustCompile(`^(ngh|ng|nh|ch|gh|gi|kh|ph|qu|th|tr|[bcdghklmnprstvx])?[aeiouy]{1,3}(ch|ng|nh|[cmnpt])?$`)
+
+// isVietnameseSyllable reports whether an ASCII word could be a Vietnamese syllable without diacritics
+func isVietnameseSyllable(word string) bool {
+	return vietnameseSyllable.MatchString(word)
+}
+
+// isAcronym reports all-caps ASCII words such as "AI" or "SQL" (spelled out, not a syllable)
+func isAcronym(word string) bool {
+	if len(word) < 2 {
+		return false
+	}
+	for _, r := range word {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// trigramModel holds character trigram log-probabilities per language
+type trigramModel struct {
+	vi, en         map[string]float64
+	viMiss, enMiss float64 // Log-probability of an unseen trigram
+}
+
+var (
+	languageModelOnce sync.Once
+	languageModelData *trigramModel
+)
+
+// languageModel builds the trigram model from the bundled corpora (once)
+func languageModel() *trigramModel {
+	languageModelOnce.Do(func() {
+		vi, viMiss := trigramLogProbs(foldVietnamese(vietnameseCorpus))
+		en, enMiss := trigramLogProbs(englishCorpus)
+		languageModelData = &trigramModel{vi: vi, en: en, viMiss: viMiss, enMiss: enMiss}
+	})
+	return languageModelData
+}
+
+// score returns the log-likelihood of words under each language and the number of trigrams
+func (m *trigramModel) score(words []string) (vi, en float64, n int) {
+	for _, w := range words {
+		for _, t := range wordTrigrams(w) {
+			if p, ok := m.vi[t]; ok {
+				vi += p
+			} else {
+				vi += m.viMiss
+			}
+			if p, ok := m.en[t]; ok {
+				en += p
+			} else {
+				en += m.enMiss
+			}
+			n++
+		}
+	}
+	return vi, en, n
+}
+
+// trigramLogProbs counts trigrams of a corpus and converts them to add-one smoothed log-probabilities
+func trigramLogProbs(corpus string) (map[string]float64, float64) {
+	counts := make(map[string]int)
+	total := 0
+
+	words := strings.FieldsFunc(strings.ToLower(corpus), func(r rune) bool { return !unicode.IsLetter(r) })
+	for _, w := range words {
+		for _, t := range wordTrigrams(w) {
+			counts[t]++
+			total++
+		}
+	}
+
+	denominator := float64(total + len(counts) + 1)
+	probs := make(map[string]float64, len(counts))
+	for t, c := range counts {
+		probs[t] = math.Log(float64(c+1) / denominator)
+	}
+	return probs, math.Log(1 / denominator)
+}
+
+// wordTrigrams returns the character trigrams of a word padded with spaces
+// Example: "ai" → [" ai", "ai "]
+func wordTrigrams(word string) []string {
+	runes := []rune(" " + word + " ")
+	if len(runes) < 3 {
+		return nil
+	}
+
+	trigrams := make([]string, 0, len(runes)-2)
+	for i := 0; i+3 <= len(runes); i++ {
+		trigrams = append(trigrams, string(runes[i:i+3]))
+	}
+	return trigrams
+}
@@ -0,0 +1,92 @@
+package domain
+
+// Bundled training text for the alias language model (DetectLanguage)
+// Topics mirror the video catalog: tech, science, finance, education, daily life
+// Vietnamese is written with diacritics and folded to ASCII when the model is built
+
+const vietnameseCorpus = `
+Học máy là một nhánh của trí tuệ nhân tạo, giúp máy tính học từ dữ liệu mà không cần lập trình cụ thể.
+Trí tuệ nhân tạo đang thay đổi cách chúng ta làm việc, học tập và giao tiếp hằng ngày.
+Lập trình viên cần hiểu cấu trúc dữ liệu và giải thuật để viết phần mềm hiệu quả.
+Mạng nơ ron nhân tạo mô phỏng cách bộ não con người xử lý thông tin.
+Khoa học dữ liệu kết hợp thống kê, toán học và tin học để phân tích thông tin.
+Cơ sở dữ liệu lưu trữ thông tin của người dùng, sản phẩm và đơn hàng.
+Phát triển ứng dụng di động và trang web là những kỹ năng được nhiều công ty tìm kiếm.
+An ninh mạng bảo vệ hệ thống máy tính khỏi tin tặc và phần mềm độc hại.
+Điện toán đám mây cho phép doanh nghiệp thuê máy chủ thay vì tự xây dựng trung tâm dữ liệu.
+
+Tài chính cá nhân giúp bạn quản lý tiền bạc, tiết kiệm và đầu tư cho tương lai.
+Thị trường chứng khoán biến động mạnh khi lãi suất ngân hàng thay đổi.
+Kinh tế học nghiên cứu cách con người sử dụng nguồn lực khan hiếm.
+Khởi nghiệp đòi hỏi ý tưởng tốt, đội ngũ mạnh và nguồn vốn ổn định.
+Tiền điện tử và chuỗi khối là chủ đề được giới trẻ quan tâm.
+Kế toán, thuế và bảo hiểm là những kiến thức cần thiết cho người kinh doanh.
+Bất động sản, vàng và ngoại tệ là các kênh đầu tư phổ biến ở Việt Nam.
+
+Vật lý lượng tử giải thích hành vi của các hạt rất nhỏ như điện tử và quang tử.
+Hóa học hữu cơ nghiên cứu các hợp chất chứa cacbon.
+Sinh học phân tử tìm hiểu cấu trúc của gien và tế bào.
+Thiên văn học quan sát các ngôi sao, hành tinh và thiên hà trong vũ trụ.
+Biến đổi khí hậu làm nhiệt độ trái đất tăng và mực nước biển dâng cao.
+Môi trường sống của động vật hoang dã đang bị thu hẹp do con người khai thác rừng.
+Y học hiện đại giúp chữa nhiều bệnh trước đây không có thuốc chữa.
+Sức khỏe tinh thần quan trọng không kém sức khỏe thể chất.
+
+Lịch sử Việt Nam gắn liền với những cuộc kháng chiến chống ngoại xâm.
+Triết học đặt câu hỏi về bản chất của thế giới, tri thức và đạo đức.
+Tâm lý học giúp chúng ta hiểu suy nghĩ và hành vi của bản thân.
+Văn học, âm nhạc, hội họa và điện ảnh là những loại hình nghệ thuật gần gũi.
+Kỹ năng mềm như giao tiếp, làm việc nhóm và quản lý thời gian rất cần thiết.
+Học tiếng Anh mỗi ngày giúp sinh viên tự tin khi đi phỏng vấn xin việc.
+Giáo dục trực tuyến mang bài giảng chất lượng đến học sinh ở vùng sâu vùng xa.
+Nấu ăn, du lịch, thể thao và nuôi dạy con là những chủ đề đời sống quen thuộc.
+Bóng đá là môn thể thao được yêu thích nhất, còn cầu lông và bơi lội rèn luyện sức khỏe.
+Người xưa nói học thầy không tày học bạn, muốn biết phải hỏi, muốn giỏi phải học.
+Chính trị, pháp luật và xã hội học giúp hiểu cách nhà nước và cộng đồng vận hành.
+Đời sống, gia đình, tình yêu, hạnh phúc, thành công, động lực và phát triển bản thân.
+Lãnh đạo, quản trị, tiếp thị, bán hàng, thương hiệu, truyền thông và mạng xã hội.
+Điện thoại, máy ảnh, trò chơi điện tử, người máy, xe điện, năng lượng mặt trời.
+`
+
+const englishCorpus = `
+Machine learning is a branch of artificial intelligence that lets computers learn from data without being explicitly programmed.
+Artificial intelligence is changing the way we work, study and communicate every day.
+Programmers need to understand data structures and algorithms to write efficient software.
+Neural networks and deep learning models are inspired by how the human brain processes information.
+Data science combines statistics, mathematics and computer science to analyze information.
+Databases store information about users, products and orders.
+Web development and mobile app development are skills that many companies are looking for.
+Cybersecurity protects computer systems from hackers, malware and phishing attacks.
+Cloud computing lets businesses rent servers instead of building their own data centers.
+Software engineering, system design, networking, operating systems and open source projects.
+
+Personal finance helps you manage money, save and invest for the future.
+The stock market moves sharply when central banks change interest rates.
+Economics studies how people use scarce resources and how markets work.
+A startup needs a good idea, a strong team and steady funding to grow.
+Cryptocurrency and blockchain technology are popular topics among young investors.
+Accounting, taxes and insurance are essential knowledge for business owners.
+Real estate, gold and foreign currency are common investment choices.
+
+Quantum physics explains the behavior of very small particles such as electrons and photons.
+Organic chemistry studies compounds that contain carbon.
+Molecular biology explores the structure of genes, proteins and cells.
+Astronomy observes the stars, planets and galaxies of the universe.
+Climate change is warming the earth and raising the sea level.
+Wildlife habitats are shrinking because of deforestation and pollution.
+Modern medicine can treat many diseases that had no cure in the past.
+Mental health is just as important as physical health and fitness.
+
+History teaches us how civilizations rose and fell over thousands of years.
+Philosophy asks questions about the nature of the world, knowledge and ethics.
+Psychology helps us understand our own thoughts, feelings and behavior.
+Literature, music, painting and film are forms of art that shape culture.
+Soft skills such as communication, teamwork and time management matter in every job.
+Learning English every day helps students feel confident in job interviews.
+Online education brings quality lectures to students in remote areas.
+Cooking, travel, sports and parenting are familiar lifestyle topics.
+Football is the most popular sport, while swimming and running keep you healthy.
+Politics, law and sociology explain how governments and communities function.
+Productivity, motivation, leadership, marketing, branding, social media and storytelling.
+Photography, video games, robotics, electric vehicles, renewable energy and space exploration.
+`
@@ -0,0 +1,38 @@
+package domain
+
+import "testing"
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"vietnamese with diacritics", "học máy", LanguageVietnamese},
+		{"vietnamese without diacritics", "hoc may", LanguageVietnamese},
+		{"vietnamese capitalized", "Kinh tế", LanguageVietnamese},
+		{"vietnamese only letter", "đầu tư", LanguageVietnamese},
+		{"english", "machine learning", LanguageEnglish},
+		{"english non-syllable word", "javascript", LanguageEnglish},
+		{"acronym only", "AI", LanguageUnknown},
+		{"acronyms only", "AI SQL", LanguageUnknown},
+		{"acronym with vietnamese", "AI tạo sinh", LanguageVietnamese},
+		{"empty", "", LanguageUnknown},
+		{"digits only", "2024", LanguageUnknown},
+		{"hiragana", "ひらがな", LanguageJapanese},
+		{"katakana", "カタカナ", LanguageJapanese},
+		{"kana with kanji", "機械学習とは", LanguageJapanese},
+		{"hangul", "머신러닝", LanguageKorean},
+		{"han", "机器学习", LanguageChinese},
+		{"thai", "การเรียนรู้ของเครื่อง", LanguageThai},
+		{"cyrillic", "машинное обучение", LanguageUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectLanguage(tt.input); got != tt.want {
+				t.Errorf("DetectLanguage(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// localePattern matches a primary language subtag ("vi", "en", "fil")
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}$`)
+
+// NormalizeLocale reduces a language tag to its lowercase primary subtag
+// Example: "vi-VN" → "vi", "EN_us" → "en", "*" → ""
+func NormalizeLocale(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	if !localePattern.MatchString(tag) {
+		return ""
+	}
+	return tag
+}
+
+// ParseLocales builds the locale preference list of a request
+// The lang query parameter (comma separated) comes first, then Accept-Language ordered by q
+// Example: ("", "vi-VN,vi;q=0.9,en;q=0.8") → ["vi", "en"]
+func ParseLocales(lang, acceptLanguage string) []string {
+	var locales []string
+	seen := make(map[string]bool)
+	add := func(tag string) {
+		if locale := NormalizeLocale(tag); locale != "" && !seen[locale] {
+			seen[locale] = true
+			locales = append(locales, locale)
+		}
+	}
+
+	for _, tag := range strings.Split(lang, ",") {
+		add(tag)
+	}
+
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var accepted []weighted
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(part, ";")
+		w := weighted{tag: fields[0], q: 1}
+		for _, param := range fields[1:] {
+			if value, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if q, err := strconv.ParseFloat(value, 64); err == nil {
+					w.q = q
+				}
+			}
+		}
+		if w.q > 0 {
+			accepted = append(accepted, w)
+		}
+	}
+	sort.SliceStable(accepted, func(i, j int) bool { return accepted[i].q > accepted[j].q })
+	for _, w := range accepted {
+		add(w.tag)
+	}
+
+	return locales
+}
+
+// LocalizedDisplayName picks a display name with the fallback chain:
+// each preferred locale in order → the default name
+// Returns the name and the locale it came from ("" = default)
+func LocalizedDisplayName(names map[string]string, locales []string, fallback string) (string, string) {
+	for _, locale := range locales {
+		if name, ok := names[locale]; ok && name != "" {
+			return name, locale
+		}
+	}
+	return fallback, ""
+}
+
+type localesKey struct{}
+
+// WithLocales returns a context carrying the request's locale preference list
+func WithLocales(ctx context.Context, locales []string) context.Context {
+	return context.WithValue(ctx, localesKey{}, locales)
+}
+
+// LocalesFromContext returns the locale preference list (nil = default names)
+func LocalesFromContext(ctx context.Context) []string {
+	locales, _ := ctx.Value(localesKey{}).([]string)
+	return locales
+}
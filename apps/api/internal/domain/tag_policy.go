@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MergeMode decides what ResolveTag does when Layer 3 finds a close canonical
+type MergeMode string
+
+const (
+	MergeModeAuto    MergeMode = "auto_merge" // Add the input as an alias of the close canonical
+	MergeModeSuggest MergeMode = "suggest"    // Create a new canonical and suggest the pair as duplicate to mods
+)
+
+// MatchStrategy decides how the distance between an input and a canonical tag is measured in Layer 3
+type MatchStrategy string
+
+const (
+	MatchStrategyAliasMin MatchStrategy = "alias_min" // Distance to the closest alias (tags with many noisy aliases attract more inputs)
+	MatchStrategyCentroid MatchStrategy = "centroid"  // Distance to the mean of the tag's alias embeddings
+	MatchStrategyHybrid   MatchStrategy = "hybrid"    // Average of the alias_min and centroid distances
+)
+
+// DefaultAutoMergeDistance is the Layer 3 cosine distance used until an admin saves a policy
+// Distance formula: similarity = 1 - (distance / 2)
+//
+// Distance 0.20 = 90% similarity (very strict)
+// Distance 0.30 = 85% similarity
+// Distance 0.40 = 80% similarity (default)
+//
+// Examples:
+// ✅ "money" ↔ "tiền" (distance ~0.25) → MERGE
+// ✅ "ML" ↔ "Machine Learning" (distance ~0.20) → MERGE
+// ❌ "money" ↔ "gái" (distance ~0.80) → DON'T MERGE
+// ❌ "finance" ↔ "sex" (distance ~1.50) → DON'T MERGE
+const DefaultAutoMergeDistance = 0.40
+
+// ResolutionPolicySettings là các tham số của ResolveTag mà admin chỉnh được lúc runtime
+type ResolutionPolicySettings struct {
+	// Ngưỡng khoảng cách cosine của Layer 3 (0 < d <= 2), có thể ghi đè theo ngôn ngữ (mã DetectLanguage)
+	AutoMergeDistance float64            `gorm:"type:float;not null" json:"auto_merge_distance"`
+	LanguageDistances map[string]float64 `gorm:"type:jsonb;serializer:json" json:"language_distances"`
+
+	// Cách đo khoảng cách tới canonical ở Layer 3 (default chỉ để thêm cột cho dòng đã có)
+	MatchStrategy MatchStrategy `gorm:"type:varchar(20);not null;default:'alias_min'" json:"match_strategy"`
+
+	MergeMode              MergeMode `gorm:"type:varchar(20);not null" json:"merge_mode"`
+	TranslationEnabled     bool      `gorm:"not null" json:"translation_enabled"`      // Layer 1.5 on/off
+	AutoApproveAliases     bool      `gorm:"not null" json:"auto_approve_aliases"`     // AI-mapped aliases skip the review queue
+	CreateWithoutEmbedding bool      `gorm:"not null" json:"create_without_embedding"` // Create canonicals when OpenAI is unavailable
+}
+
+// ResolutionPolicy là cấu hình runtime hiện tại (bảng chỉ có một dòng, ID = 1)
+type ResolutionPolicy struct {
+	ID                       uint `gorm:"primaryKey"`
+	ResolutionPolicySettings `gorm:"embedded"`
+
+	UpdatedBy *uuid.UUID `gorm:"type:uuid"`
+	UpdatedAt time.Time
+}
+
+// ResolutionPolicyChange ghi lại mỗi lần admin thay đổi policy (audit trail)
+type ResolutionPolicyChange struct {
+	ID        uint                     `gorm:"primaryKey;autoIncrement"`
+	ChangedBy uuid.UUID                `gorm:"type:uuid;not null;index"`
+	Before    ResolutionPolicySettings `gorm:"type:jsonb;serializer:json;not null"`
+	After     ResolutionPolicySettings `gorm:"type:jsonb;serializer:json;not null"`
+	Reason    string                   `gorm:"type:varchar(500)"`
+	CreatedAt time.Time                `gorm:"index"`
+}
+
+func (ResolutionPolicy) TableName() string       { return "tag_resolution_policies" }
+func (ResolutionPolicyChange) TableName() string { return "tag_resolution_policy_changes" }
+
+// DefaultResolutionPolicy returns the behaviour ResolveTag had before the policy was configurable
+func DefaultResolutionPolicy() *ResolutionPolicy {
+	return &ResolutionPolicy{
+		ID: 1,
+		ResolutionPolicySettings: ResolutionPolicySettings{
+			AutoMergeDistance:      DefaultAutoMergeDistance,
+			LanguageDistances:      map[string]float64{},
+			MatchStrategy:          MatchStrategyAliasMin,
+			MergeMode:              MergeModeAuto,
+			TranslationEnabled:     true,
+			AutoApproveAliases:     false,
+			CreateWithoutEmbedding: true,
+		},
+	}
+}
+
+// DistanceFor returns the Layer 3 distance threshold for an input language
+func (p ResolutionPolicySettings) DistanceFor(language string) float64 {
+	if distance, ok := p.LanguageDistances[language]; ok {
+		return distance
+	}
+	return p.AutoMergeDistance
+}
+
+// Validate checks the settings before they are saved
+func (p ResolutionPolicySettings) Validate() error {
+	if p.AutoMergeDistance <= 0 || p.AutoMergeDistance > 2 {
+		return fmt.Errorf("%w: auto_merge_distance must be in (0, 2]", ErrInvalidRequest)
+	}
+	for language, distance := range p.LanguageDistances {
+		if language == "" {
+			return fmt.Errorf("%w: language_distances keys cannot be empty", ErrInvalidRequest)
+		}
+		if distance <= 0 || distance > 2 {
+			return fmt.Errorf("%w: language_distances[%s] must be in (0, 2]", ErrInvalidRequest, language)
+		}
+	}
+	switch p.MatchStrategy {
+	case MatchStrategyAliasMin, MatchStrategyCentroid, MatchStrategyHybrid:
+	default:
+		return fmt.Errorf("%w: match_strategy must be alias_min, centroid or hybrid", ErrInvalidRequest)
+	}
+	if p.MergeMode != MergeModeAuto && p.MergeMode != MergeModeSuggest {
+		return fmt.Errorf("%w: merge_mode must be auto_merge or suggest", ErrInvalidRequest)
+	}
+	return nil
+}
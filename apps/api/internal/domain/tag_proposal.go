@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TagProposalStatus is the review state of a user tag proposal
+type TagProposalStatus string
+
+const (
+	TagProposalPending  TagProposalStatus = "pending"  // Waiting in the mod queue
+	TagProposalApproved TagProposalStatus = "approved" // Tag linked to the video
+	TagProposalRejected TagProposalStatus = "rejected" // Not linked, see RejectionReason
+)
+
+// TagProposal là đề xuất gắn tag cho video từ người dùng thường
+// Chỉ trở thành liên kết video_canonical_tags công khai sau khi mod duyệt
+type TagProposal struct {
+	ID         uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	VideoID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	ProposerID uuid.UUID `gorm:"type:uuid;not null;index"`
+
+	// Tag do người dùng nhập (chỉ resolve thành canonical khi được duyệt)
+	TagName        string `gorm:"type:varchar(100);not null"`
+	NormalizedText string `gorm:"type:varchar(100);not null;index"`
+
+	Status TagProposalStatus `gorm:"type:varchar(20);not null;default:'pending';index"`
+
+	// Kết quả review
+	CanonicalTagID  *uuid.UUID `gorm:"type:uuid"` // Tag linked on approval (nil while pending / rejected)
+	RejectionReason string     `gorm:"type:varchar(500)"`
+	ReviewedBy      *uuid.UUID `gorm:"type:uuid"`
+	ReviewedAt      *time.Time
+
+	CreatedAt time.Time `gorm:"index"`
+	UpdatedAt time.Time
+
+	// Relationships
+	Video        *Video        `gorm:"foreignKey:VideoID;constraint:OnDelete:CASCADE"`
+	Proposer     *User         `gorm:"foreignKey:ProposerID;constraint:OnDelete:CASCADE"`
+	CanonicalTag *CanonicalTag `gorm:"foreignKey:CanonicalTagID;constraint:OnDelete:SET NULL"`
+}
+
+// TagProposalFilter lọc danh sách đề xuất (trường rỗng = không lọc)
+type TagProposalFilter struct {
+	Status     TagProposalStatus
+	ProposerID *uuid.UUID
+	VideoID    *uuid.UUID
+}
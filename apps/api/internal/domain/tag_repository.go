@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
@@ -17,30 +18,54 @@ type TagRepository interface {
 	// Returns nil if not found (not an error, proceed to Layer 2)
 	GetCanonicalByAlias(ctx context.Context, normalizedText string) (*CanonicalTag, error)
 
+	// GetCanonicalsByAliases finds the canonical tags of many normalized texts in one lookup (batched Layer 1)
+	// Texts without an alias are absent from the result map
+	GetCanonicalsByAliases(ctx context.Context, normalizedTexts []string) (map[string]*CanonicalTag, error)
+
 	// GetClosestCanonical finds most similar canonical tag using vector search (Layer 3)
+	// strategy decides whether the distance is measured to the closest alias, the centroid or both
 	// Returns (CanonicalTag, similarity_score, error)
 	// If no match above threshold, returns (nil, 0, nil)
-	GetClosestCanonical(ctx context.Context, embedding pgvector.Vector, threshold float64) (*CanonicalTag, float64, error)
+	GetClosestCanonical(ctx context.Context, embedding pgvector.Vector, threshold float64, strategy MatchStrategy) (*CanonicalTag, float64, error)
+
+	// GetNearestCanonicals returns the top-K canonical tags closest to the embedding under strategy, without any threshold
+	// Returns empty slice if OpenAI is not available
+	GetNearestCanonicals(ctx context.Context, embedding pgvector.Vector, strategy MatchStrategy, limit int) ([]CanonicalMatch, error)
+
+	// GetNearestAliases returns the top-K aliases closest to the embedding, without any threshold
+	// Read-only: used to explain Layer 3 decisions (dry-run resolution)
+	// Returns empty slice if OpenAI is not available
+	GetNearestAliases(ctx context.Context, embedding pgvector.Vector, limit int) ([]AliasMatch, error)
 
 	// CreateCanonicalTag creates a new canonical tag with its initial alias (atomic transaction)
 	// Used when: No similar tag found (Layer 4 - Scenario B)
+	// Serialized per alias text: returns ErrAliasTaken if a concurrent request created it first
+	// A slug used by another tag gets a numeric suffix ("ai" → "ai-2")
 	CreateCanonicalTag(ctx context.Context, canonical *CanonicalTag, initialAlias *TagAlias) error
 
 	// CreateAlias adds a new alias to existing canonical tag
 	// Used when: Similar tag found (Layer 4 - Scenario A)
+	// Returns ErrAliasTaken if the alias text already exists
 	CreateAlias(ctx context.Context, alias *TagAlias) error
 
 	// GetCanonicalByID retrieves canonical tag by ID
 	GetCanonicalByID(ctx context.Context, id uuid.UUID) (*CanonicalTag, error)
 
+	// GetCanonicalsByIDs retrieves canonical tags (with aliases) by ID; missing IDs are skipped
+	GetCanonicalsByIDs(ctx context.Context, ids []uuid.UUID) ([]CanonicalTag, error)
+
 	// UpdateCanonicalTag updates a canonical tag
 	UpdateCanonicalTag(ctx context.Context, canonical *CanonicalTag) error
 
 	// ListCanonicalTags returns paginated list of canonical tags
-	ListCanonicalTags(ctx context.Context, page, limit int, approvedOnly bool) ([]CanonicalTag, int64, error)
+	// TagSortGrowing ranks by videos tagged since growthSince (ignored by other sorts)
+	ListCanonicalTags(ctx context.Context, page, limit int, approvedOnly bool, sort TagSort, growthSince time.Time) ([]CanonicalTag, int64, error)
+
+	// GetTrendingTags returns the tags with the most videos tagged since the given time
+	GetTrendingTags(ctx context.Context, since time.Time, limit int, approvedOnly bool) ([]TrendingTag, error)
 
-	// SearchCanonicalTags searches canonical tags (hybrid: SQL LIKE + Vector)
-	SearchCanonicalTags(ctx context.Context, query string, limit int, approvedOnly bool) ([]CanonicalTag, error)
+	// SearchCanonicalTags searches canonical tags (hybrid: SQL LIKE + Vector under strategy)
+	SearchCanonicalTags(ctx context.Context, query string, limit int, approvedOnly bool, strategy MatchStrategy) ([]CanonicalTag, error)
 
 	// ============================================================
 	// Video-Canonical Tag Relationship
@@ -49,6 +74,10 @@ type TagRepository interface {
 	// AddCanonicalTagToVideo links a canonical tag to a video
 	AddCanonicalTagToVideo(ctx context.Context, videoID, canonicalTagID uuid.UUID) error
 
+	// AddCanonicalTagsToVideos links many (video, tag) pairs at once, skipping existing links
+	// Returns only the links that were created
+	AddCanonicalTagsToVideos(ctx context.Context, links []VideoTagLink) ([]VideoTagLink, error)
+
 	// RemoveCanonicalTagFromVideo unlinks a canonical tag from a video
 	RemoveCanonicalTagFromVideo(ctx context.Context, videoID, canonicalTagID uuid.UUID) error
 
@@ -62,12 +91,210 @@ type TagRepository interface {
 	// MergeTags merges source canonical tag into target canonical tag
 	// All aliases and relationships of source will be moved to target
 	// Source canonical tag will be deleted
-	// Returns number of aliases merged and any error
-	MergeTags(ctx context.Context, sourceID, targetID uuid.UUID) (int, error)
+	// Returns the merge log (source snapshot, moved aliases, video links) and any error
+	MergeTags(ctx context.Context, sourceID, targetID uuid.UUID, mergedBy *uuid.UUID) (*TagMergeLog, error)
+
+	// GetMergeLogByID retrieves a merge log with its aliases and videos
+	GetMergeLogByID(ctx context.Context, id uint) (*TagMergeLog, error)
+
+	// UndoMerge restores the source tag, its aliases and video links exactly as before the merge
+	// Returns ErrMergeUndoConflict if the tags changed since the merge
+	UndoMerge(ctx context.Context, id uint, undoneBy uuid.UUID) (*TagMergeLog, error)
+
+	// DeleteCanonicalTag unlinks a tag from all videos, deletes its aliases and the tag itself
+	// Children are re-parented to the tag's parent; returns the unlinked video and deleted alias counts
+	DeleteCanonicalTag(ctx context.Context, tagID uuid.UUID) (videoCount, aliasCount int, err error)
 
 	// GetAliasCountByCanonicalID returns the number of aliases for a canonical tag
 	GetAliasCountByCanonicalID(ctx context.Context, canonicalID uuid.UUID) (int, error)
 
+	// ============================================================
+	// Alias Management
+	// ============================================================
+
+	// GetCanonicalBySlug retrieves canonical tag by slug
+	// Returns nil if not found (not an error)
+	GetCanonicalBySlug(ctx context.Context, slug string) (*CanonicalTag, error)
+
+	// ListAliasesByCanonicalID returns all aliases of a canonical tag (embeddings omitted)
+	ListAliasesByCanonicalID(ctx context.Context, canonicalID uuid.UUID) ([]TagAlias, error)
+
+	// GetAliasByID retrieves an alias by ID (embedding omitted)
+	// Returns ErrAliasNotFound if it does not exist
+	GetAliasByID(ctx context.Context, aliasID uuid.UUID) (*TagAlias, error)
+
+	// MoveAlias re-points an alias to another canonical tag and marks it reviewed
+	// If review is not nil, reviewer info is stored and the review log is written in the same transaction
+	// Returns ErrLastAlias if the alias is the only one left on its canonical
+	MoveAlias(ctx context.Context, aliasID, targetID uuid.UUID, review *TagAliasReviewLog) error
+
+	// SplitAlias detaches an alias into a brand-new canonical tag (atomic transaction)
+	// canonical.Slug is replaced by the first free slug candidate of its display name
+	// If review is not nil, reviewer info is stored and the review log is written in the same transaction
+	// Returns ErrLastAlias if the alias is the only one left on its canonical
+	SplitAlias(ctx context.Context, aliasID uuid.UUID, canonical *CanonicalTag, review *TagAliasReviewLog) error
+
+	// DeleteAlias removes an alias
+	// Returns ErrLastAlias if the alias is the only one left on its canonical
+	DeleteAlias(ctx context.Context, aliasID uuid.UUID) error
+
+	// ReopenAliasReview marks an alias unreviewed and clears its reviewer info
+	ReopenAliasReview(ctx context.Context, aliasID uuid.UUID) error
+
+	// ============================================================
+	// Alias Review Queue
+	// ============================================================
+
+	// ListUnreviewedAliases returns aliases with is_reviewed = false, lowest similarity first
+	ListUnreviewedAliases(ctx context.Context, page, limit int) ([]TagAlias, int64, error)
+
+	// CountUnreviewedAliases returns the number of aliases waiting for review
+	CountUnreviewedAliases(ctx context.Context) (int64, error)
+
+	// GetAlternativeCanonicals returns, for each alias, the canonicals closest to its embedding,
+	// excluding the canonical the alias currently belongs to (keyed by alias ID)
+	GetAlternativeCanonicals(ctx context.Context, aliasIDs []uuid.UUID, limit int) (map[uuid.UUID][]CanonicalMatch, error)
+
+	// GetTaggedVideos returns, for each canonical tag, a sample of its most recent videos (keyed by tag ID)
+	GetTaggedVideos(ctx context.Context, canonicalIDs []uuid.UUID, limit int) (map[uuid.UUID][]Video, error)
+
+	// AcceptAlias marks an alias reviewed by a mod and writes the review log (atomic transaction)
+	AcceptAlias(ctx context.Context, review *TagAliasReviewLog) error
+
+	// GetAliasReviewStats returns per-mod review counts since the given time
+	GetAliasReviewStats(ctx context.Context, since time.Time) ([]AliasReviewerStats, error)
+
+	// ============================================================
+	// Rename & Slug History
+	// ============================================================
+
+	// RenameCanonicalTag sets a new display name and the first free slug from slugCandidates
+	// The old slug is kept in tag_slug_history; oldNameAlias (optional) is added unless its text exists
+	// Returns ErrSlugTaken if every candidate is used by another tag (current or historic slug)
+	RenameCanonicalTag(ctx context.Context, tagID uuid.UUID, displayName string, slugCandidates []string, oldNameAlias *TagAlias) (*CanonicalTag, error)
+
+	// GetCanonicalBySlugHistory finds the tag that previously used a slug
+	// Returns nil if the slug was never used (not an error)
+	GetCanonicalBySlugHistory(ctx context.Context, slug string) (*CanonicalTag, error)
+
+	// ============================================================
+	// Localized Display Names
+	// ============================================================
+
+	// GetTagDisplayNames returns the localized names of tags: tagID → locale → name
+	GetTagDisplayNames(ctx context.Context, tagIDs []uuid.UUID) (map[uuid.UUID]map[string]string, error)
+
+	// SetTagDisplayName creates or replaces the name of a tag for one locale
+	SetTagDisplayName(ctx context.Context, name *TagDisplayName) error
+
+	// DeleteTagDisplayName removes the name of a tag for one locale
+	// Returns false if the tag had no name for that locale
+	DeleteTagDisplayName(ctx context.Context, tagID uuid.UUID, locale string) (bool, error)
+
+	// ============================================================
+	// Tag Hierarchy
+	// ============================================================
+
+	// SetCanonicalParent moves a tag (and its whole subtree) under parentID, or to the root if nil
+	// Returns ErrTagCycle if parentID is the tag itself or one of its descendants
+	SetCanonicalParent(ctx context.Context, tagID uuid.UUID, parentID *uuid.UUID) error
+
+	// GetTagTreeNodes returns every canonical tag with its parent and video counts
+	GetTagTreeNodes(ctx context.Context) ([]TagTreeNode, error)
+
+	// ============================================================
+	// Taxonomy Export / Import
+	// ============================================================
+
+	// ListAllCanonicalTags returns every canonical tag with its aliases, ordered by slug
+	// Alias embeddings are only loaded when includeEmbeddings is true
+	ListAllCanonicalTags(ctx context.Context, includeEmbeddings bool) ([]CanonicalTag, error)
+
+	// ImportTaxonomy upserts tags by slug and aliases by normalized text (atomic transaction)
+	// Conflicting parts are skipped and reported; with dryRun nothing is written
+	ImportTaxonomy(ctx context.Context, tags []TaxonomyTag, dryRun bool) (*TaxonomyImportResult, error)
+
+	// SetAliasEmbeddings stores embeddings for aliases (same order as aliasIDs)
+	SetAliasEmbeddings(ctx context.Context, aliasIDs []uuid.UUID, embeddings [][]float32) error
+
+	// ============================================================
+	// Duplicate Detection
+	// ============================================================
+
+	// FindDuplicatePairs returns canonical pairs whose closest aliases are within maxDistance
+	// or whose display names have trigram similarity >= minTrigram
+	FindDuplicatePairs(ctx context.Context, maxDistance, minTrigram float64) ([]DuplicatePair, error)
+
+	// SaveDuplicateCandidates upserts pending candidates (resolved pairs are left untouched)
+	// and removes pending candidates not refreshed since refreshedAt (stale pairs)
+	SaveDuplicateCandidates(ctx context.Context, candidates []TagDuplicateCandidate, refreshedAt time.Time) error
+
+	// ListDuplicateCandidates returns pending candidates, highest score first
+	ListDuplicateCandidates(ctx context.Context, page, limit int) ([]TagDuplicateCandidate, int64, error)
+
+	// GetDuplicateCandidateByID retrieves a duplicate candidate by ID
+	GetDuplicateCandidateByID(ctx context.Context, id uint) (*TagDuplicateCandidate, error)
+
+	// ResolveDuplicateCandidate sets the final status of a pending candidate
+	// Returns ErrDuplicateResolved if the candidate is no longer pending
+	ResolveDuplicateCandidate(ctx context.Context, id uint, status DuplicateStatus, reviewerID uuid.UUID) error
+
+	// AddDuplicateCandidate stores one pending candidate unless the pair is already known
+	AddDuplicateCandidate(ctx context.Context, candidate *TagDuplicateCandidate) error
+
+	// GetCoOccurringVideos returns, for each duplicate candidate, a sample of videos tagged with both tags (keyed by candidate ID)
+	GetCoOccurringVideos(ctx context.Context, candidateIDs []uint, limit int) (map[uint][]Video, error)
+
+	// ============================================================
+	// Related Tags
+	// ============================================================
+
+	// FindRelatedTagPairs returns directed tag pairs that co-occur on videos
+	// or whose alias-embedding centroids are among the neighborsPerTag closest
+	FindRelatedTagPairs(ctx context.Context, neighborsPerTag int) ([]RelatedTagPair, error)
+
+	// ReplaceTagRelations replaces all stored relations with the given ones (atomic transaction)
+	ReplaceTagRelations(ctx context.Context, relations []TagRelation) error
+
+	// GetRelatedTags returns the stored relations of a tag, highest score first
+	// Relations pointing to deleted (or, with approvedOnly, unapproved) tags are skipped
+	GetRelatedTags(ctx context.Context, tagID uuid.UUID, limit int, approvedOnly bool) ([]RelatedTag, error)
+
+	// ============================================================
+	// Resolution Policy
+	// ============================================================
+
+	// GetResolutionPolicy returns the stored policy, or the default one if none was saved
+	GetResolutionPolicy(ctx context.Context) (*ResolutionPolicy, error)
+
+	// UpdateResolutionPolicy applies update to the current settings and writes the audit entry (atomic transaction)
+	UpdateResolutionPolicy(ctx context.Context, update func(ResolutionPolicySettings) (ResolutionPolicySettings, error), changedBy uuid.UUID, reason string) (*ResolutionPolicy, error)
+
+	// ListResolutionPolicyChanges returns the policy audit trail, newest first
+	ListResolutionPolicyChanges(ctx context.Context, page, limit int) ([]ResolutionPolicyChange, int64, error)
+
+	// ============================================================
+	// User Tag Proposals
+	// ============================================================
+
+	// CreateTagProposal stores a pending proposal
+	// Returns ErrProposalExists if the video already has a pending proposal with the same normalized text
+	CreateTagProposal(ctx context.Context, proposal *TagProposal) error
+
+	// GetTagProposalByID retrieves a proposal with its video, proposer and linked tag
+	GetTagProposalByID(ctx context.Context, id uuid.UUID) (*TagProposal, error)
+
+	// ListTagProposals returns proposals matching the filter (pending queue oldest first, otherwise newest first)
+	ListTagProposals(ctx context.Context, filter TagProposalFilter, page, limit int) ([]TagProposal, int64, error)
+
+	// ApproveTagProposal marks a pending proposal approved and links its tag to the video (atomic transaction)
+	// Returns ErrProposalReviewed if the proposal is no longer pending
+	ApproveTagProposal(ctx context.Context, id, canonicalTagID, reviewerID uuid.UUID, approveTag bool) error
+
+	// RejectTagProposal marks a pending proposal rejected with the reviewer's reason
+	// Returns ErrProposalReviewed if the proposal is no longer pending
+	RejectTagProposal(ctx context.Context, id, reviewerID uuid.UUID, reason string) error
+
 	// ============================================================
 	// Translation Layer (New)
 	// ============================================================
@@ -77,11 +304,19 @@ type TagRepository interface {
 	// Returns empty string if OpenAI client is not available
 	TranslateText(ctx context.Context, text string) (string, error)
 
+	// TranslateTexts translates many terms to English in one OpenAI call
+	// Result order matches texts; returns empty strings if OpenAI client is not available
+	TranslateTexts(ctx context.Context, texts []string) ([]string, error)
+
 	// ============================================================
 	// Shared Utilities
 	// ============================================================
 	GetEmbeddingForText(ctx context.Context, text string) ([]float32, error)
 
+	// GetEmbeddingsForTexts embeds many texts with BatchGetEmbeddings (one API call per chunk)
+	// Result order matches texts
+	GetEmbeddingsForTexts(ctx context.Context, texts []string) ([][]float32, error)
+
 	// ============================================================
 	// Legacy Tag CRUD (DEPRECATED - Removed, use Tag V2 API)
 	// ============================================================
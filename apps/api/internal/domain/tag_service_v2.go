@@ -3,6 +3,8 @@ package domain
 import (
 	"api/internal/dto"
 	"context"
+
+	"github.com/google/uuid"
 )
 
 // TagServiceV2 provides canonical-alias architecture API for tag management
@@ -16,12 +18,20 @@ type TagServiceV2 interface {
 	// Returns: (CanonicalTag, matchedAlias, isNewTag, error)
 	ResolveTag(ctx context.Context, userInput string) (*CanonicalTag, string, bool, error)
 
+	// ExplainResolveTag runs the same 4 layers as ResolveTag in dry-run mode
+	// Nothing is written: no alias or canonical is created
+	// Returns a trace of each layer's decision and the top-K nearest aliases
+	ExplainResolveTag(ctx context.Context, userInput string, topK int) (*dto.TagResolveTraceResponse, error)
+
 	// ============================================================
 	// Canonical Tag CRUD
 	// ============================================================
 
 	GetCanonicalTagByID(ctx context.Context, id string) (*dto.TagResponse, error)
 	ListCanonicalTags(ctx context.Context, req dto.TagListRequest) (*dto.TagListResponse, error)
+
+	// GetTrendingTags returns the tags with the most videos tagged in the last req.Days days
+	GetTrendingTags(ctx context.Context, req dto.TrendingTagsRequest) ([]dto.TrendingTagResponse, error)
 	SearchCanonicalTags(ctx context.Context, query string, limit int, approvedOnly bool) ([]dto.TagResponse, error)
 
 	// ============================================================
@@ -33,14 +43,171 @@ type TagServiceV2 interface {
 	RemoveCanonicalTagFromVideo(ctx context.Context, videoID, canonicalTagID string) error
 	GetVideoCanonicalTags(ctx context.Context, videoID string) ([]dto.TagResponse, error)
 
+	// BulkTagVideos applies a list of tag names or IDs to a list of videos
+	// Each distinct name is resolved once; results are reported per (video, tag) item
+	BulkTagVideos(ctx context.Context, req dto.BulkVideoTagRequest) (*dto.BulkVideoTagResponse, error)
+
+	// ImportVideoTags applies per-video tag lists (CSV import), same resolution as BulkTagVideos
+	ImportVideoTags(ctx context.Context, assignments []dto.VideoTagAssignment) (*dto.BulkVideoTagResponse, error)
+
+	// ResolveTagBatch resolves many tag names with batched lookups, translation and embeddings
+	// Optionally links every resolved tag to one video in a single transaction
+	ResolveTagBatch(ctx context.Context, req dto.ResolveTagBatchRequest) (*dto.ResolveTagBatchResponse, error)
+
 	// ============================================================
 	// Tag Merge Operations
 	// ============================================================
 
 	// MergeTags manually merges source tag into target tag
 	// Source tag becomes an alias pointing to target canonical tag
-	// Returns merged tag info and error
-	MergeTags(ctx context.Context, req dto.MergeTagsRequest) (*dto.MergeTagsResponse, error)
+	// Returns merged tag info (with merge log ID for undo) and error
+	MergeTags(ctx context.Context, req dto.MergeTagsRequest, mergedBy uuid.UUID) (*dto.MergeTagsResponse, error)
+
+	// UndoMerge restores the source tag of a merge, provided nothing changed since
+	UndoMerge(ctx context.Context, mergeID string, undoneBy uuid.UUID) (*dto.UndoMergeResponse, error)
+
+	// DeleteTag deletes a canonical tag; with reassignTo its videos and aliases are merged into that tag
+	// Approved tags can only be deleted by admins (ErrAdminRequired)
+	DeleteTag(ctx context.Context, tagID, reassignTo string, deletedBy uuid.UUID, isAdmin bool) (*dto.DeleteTagResponse, error)
+
+	// ============================================================
+	// Alias Management
+	// ============================================================
+
+	// ListTagAliases returns all aliases of a canonical tag with review metadata
+	ListTagAliases(ctx context.Context, tagID string) ([]dto.TagAliasResponse, error)
+
+	// MoveAlias moves an alias to another canonical tag (e.g. fixing a wrong auto-merge)
+	MoveAlias(ctx context.Context, aliasID string, req dto.MoveAliasRequest) (*dto.TagAliasResponse, error)
+
+	// SplitAlias detaches an alias into its own new canonical tag
+	SplitAlias(ctx context.Context, aliasID string, req dto.SplitAliasRequest) (*dto.TagResponse, error)
+
+	// DeleteAlias deletes an alias (refused if it is the last one of its canonical)
+	DeleteAlias(ctx context.Context, aliasID string) error
+
+	// UpdateAliasReview marks an alias as reviewed by reviewerID (same as AcceptAlias) or puts it back in the review queue
+	UpdateAliasReview(ctx context.Context, aliasID string, reviewerID uuid.UUID, isReviewed bool) (*dto.TagAliasResponse, error)
+
+	// ============================================================
+	// Alias Review Queue
+	// ============================================================
+
+	// GetAliasReviewQueue returns unreviewed aliases (lowest similarity first) with
+	// nearest alternative canonicals and affected videos
+	GetAliasReviewQueue(ctx context.Context, page, limit int) (*dto.AliasReviewQueueResponse, error)
+
+	// AcceptAlias confirms an alias on its current canonical
+	AcceptAlias(ctx context.Context, aliasID string, reviewerID uuid.UUID) (*dto.TagAliasResponse, error)
+
+	// ReassignAlias moves an alias to another canonical from the review queue
+	ReassignAlias(ctx context.Context, aliasID string, reviewerID uuid.UUID, req dto.MoveAliasRequest) (*dto.TagAliasResponse, error)
+
+	// SplitReviewedAlias splits an alias into a new canonical from the review queue
+	SplitReviewedAlias(ctx context.Context, aliasID string, reviewerID uuid.UUID, req dto.SplitAliasRequest) (*dto.TagResponse, error)
+
+	// GetAliasReviewStats returns per-mod review throughput over the last N days
+	GetAliasReviewStats(ctx context.Context, days int) (*dto.AliasReviewStatsResponse, error)
+
+	// ============================================================
+	// Rename & Slug History
+	// ============================================================
+
+	// RenameTag renames a canonical tag, regenerating its slug and keeping the old name as alias
+	RenameTag(ctx context.Context, tagID string, req dto.RenameTagRequest) (*dto.RenameTagResponse, error)
+
+	// GetTagBySlug resolves a current or historic slug to the current tag
+	GetTagBySlug(ctx context.Context, slug string) (*dto.TagBySlugResponse, error)
+
+	// ============================================================
+	// Localized Display Names
+	// ============================================================
+	// Read endpoints localize tag names for the locales in ctx (domain.WithLocales)
+
+	// PromoteAliasDisplayName makes an alias of the tag its display name for a locale
+	PromoteAliasDisplayName(ctx context.Context, tagID string, req dto.PromoteAliasDisplayNameRequest) (*dto.TagResponse, error)
+
+	// DeleteTagDisplayName removes the display name of a tag for one locale
+	DeleteTagDisplayName(ctx context.Context, tagID, locale string) (*dto.TagResponse, error)
+
+	// ============================================================
+	// Tag Hierarchy
+	// ============================================================
+
+	// SetTagParent moves a tag with its subtree under another tag (nil parent = root)
+	SetTagParent(ctx context.Context, tagID string, req dto.SetTagParentRequest) (*dto.TagResponse, error)
+
+	// GetTagTree returns the topic tree with per-node video counts
+	// approvedOnly hides unapproved tags, lifting their children to the nearest shown ancestor
+	GetTagTree(ctx context.Context, approvedOnly bool) ([]dto.TagTreeNodeResponse, error)
+
+	// ============================================================
+	// Taxonomy Export / Import
+	// ============================================================
+
+	// ExportTaxonomy exports all canonical tags with aliases as a versioned taxonomy file
+	ExportTaxonomy(ctx context.Context, includeEmbeddings bool) (*dto.TaxonomyFile, error)
+
+	// ImportTaxonomy upserts a taxonomy file by slug and normalized alias, reporting conflicts
+	// With dryRun nothing is written
+	ImportTaxonomy(ctx context.Context, file dto.TaxonomyFile, dryRun bool) (*dto.TaxonomyImportResponse, error)
+
+	// ============================================================
+	// Duplicate Detection
+	// ============================================================
+
+	// DetectDuplicateTags scans canonical tags for likely duplicates and stores candidate pairs
+	// Returns the number of pending candidates after the scan
+	DetectDuplicateTags(ctx context.Context) (int, error)
+
+	// ListDuplicateCandidates returns pending duplicate pairs with scores and evidence
+	ListDuplicateCandidates(ctx context.Context, page, limit int) (*dto.TagDuplicateListResponse, error)
+
+	// AcceptDuplicate merges the pair via MergeTags and marks the candidate accepted
+	AcceptDuplicate(ctx context.Context, candidateID string, reviewerID uuid.UUID, req dto.AcceptDuplicateRequest) (*dto.MergeTagsResponse, error)
+
+	// DismissDuplicate marks the pair as not a duplicate so it is never suggested again
+	DismissDuplicate(ctx context.Context, candidateID string, reviewerID uuid.UUID) error
+
+	// ============================================================
+	// Related Tags
+	// ============================================================
+
+	// RefreshRelatedTags recomputes related tags for every canonical tag
+	// Returns the number of stored relations
+	RefreshRelatedTags(ctx context.Context) (int, error)
+
+	// GetRelatedTags returns the "see also" tags of a canonical tag
+	GetRelatedTags(ctx context.Context, tagID string, limit int, approvedOnly bool) ([]dto.RelatedTagResponse, error)
+
+	// ============================================================
+	// Resolution Policy
+	// ============================================================
+
+	// GetResolutionPolicy returns the runtime settings used by ResolveTag
+	GetResolutionPolicy(ctx context.Context) (*dto.ResolutionPolicyResponse, error)
+
+	// UpdateResolutionPolicy changes the given settings and records the change in the audit trail
+	UpdateResolutionPolicy(ctx context.Context, req dto.UpdateResolutionPolicyRequest, changedBy uuid.UUID) (*dto.ResolutionPolicyResponse, error)
+
+	// ListResolutionPolicyChanges returns the policy audit trail, newest first
+	ListResolutionPolicyChanges(ctx context.Context, page, limit int) (*dto.ResolutionPolicyChangeListResponse, error)
+
+	// ============================================================
+	// User Tag Proposals
+	// ============================================================
+
+	// ProposeTag stores a pending tag proposal for a video from a regular user
+	ProposeTag(ctx context.Context, videoID string, proposerID uuid.UUID, req dto.CreateTagProposalRequest) (*dto.TagProposalResponse, error)
+
+	// ListTagProposals returns proposals by status ("" = all), optionally only those of one proposer
+	ListTagProposals(ctx context.Context, status string, proposerID *uuid.UUID, page, limit int) (*dto.TagProposalListResponse, error)
+
+	// ApproveTagProposal resolves the proposed name (or uses req.TagID) and links the tag to the video
+	ApproveTagProposal(ctx context.Context, proposalID string, reviewerID uuid.UUID, req dto.ApproveTagProposalRequest) (*dto.TagProposalResponse, error)
+
+	// RejectTagProposal closes the proposal without linking, keeping the reason for the proposer
+	RejectTagProposal(ctx context.Context, proposalID string, reviewerID uuid.UUID, req dto.RejectTagProposalRequest) (*dto.TagProposalResponse, error)
 
 	// ============================================================
 	// Tag Approval Operations
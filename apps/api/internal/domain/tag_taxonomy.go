@@ -0,0 +1,65 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Taxonomy file format (export/import between environments)
+const (
+	TaxonomyFormat        = "ttt-tag-taxonomy"
+	TaxonomyFormatVersion = 1
+	TaxonomyEmbeddingDims = 1536 // Embeddings with another size are ignored on import
+)
+
+// Taxonomy conflict kinds
+const (
+	TaxonomyConflictAliasElsewhere = "alias_elsewhere"  // Alias already points to another canonical in the target
+	TaxonomyConflictNoFreeAlias    = "no_free_alias"    // New tag skipped: all its aliases belong to other tags
+	TaxonomyConflictParentNotFound = "parent_not_found" // Parent slug missing in file and target
+	TaxonomyConflictParentCycle    = "parent_cycle"     // Parent would create a cycle in the target tree
+	TaxonomyConflictInvalidTag     = "invalid_tag"      // Missing or malformed slug, missing display name, or duplicate slug in file
+)
+
+// TaxonomyTag là một canonical tag trong file taxonomy (định danh bằng slug, không phải ID)
+type TaxonomyTag struct {
+	Slug        string
+	DisplayName string
+	IsApproved  bool
+	ParentSlug  string // "" = node gốc
+	Aliases     []TaxonomyAlias
+}
+
+// TaxonomyAlias là một alias trong file taxonomy; Language rỗng = tự nhận diện, Embedding nil = sinh lại sau khi import
+type TaxonomyAlias struct {
+	Text       string
+	Language   string
+	IsReviewed bool
+	Embedding  []float32
+}
+
+// TaxonomyConflict mô tả một phần của file không được áp dụng
+type TaxonomyConflict struct {
+	Kind         string
+	Slug         string // Tag in the file
+	Alias        string // Alias text (alias conflicts only)
+	ExistingSlug string // Tag that currently owns the alias / parent slug
+	Message      string
+}
+
+// TaxonomyImportResult là kết quả import taxonomy
+type TaxonomyImportResult struct {
+	TagsCreated      int
+	TagsUpdated      int
+	TagsUnchanged    int
+	AliasesCreated   int
+	AliasesUnchanged int
+	ParentsSet       int
+	Conflicts        []TaxonomyConflict
+
+	// Created aliases without embedding (to regenerate after the import commits)
+	MissingEmbeddings []AliasText
+}
+
+// AliasText là ID và nội dung của một alias (dùng khi cần sinh embedding)
+type AliasText struct {
+	ID      uuid.UUID
+	RawText string
+}
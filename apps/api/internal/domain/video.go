@@ -1,12 +1,59 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+// Sentinel errors for videos (ErrVideoNotFound lives with the tag errors)
+var (
+	ErrVideoExists   = errors.New("video already exists") // Matched by VideoExistsError
+	ErrVideoModified = errors.New("video was modified by someone else, reload and retry")
+)
+
+// Metadata fields a mod can edit; an edited field is locked against the scheduled refresh
+// Values are the column names
+const (
+	VideoFieldTitle        = "title"
+	VideoFieldDescription  = "description"
+	VideoFieldThumbnailURL = "thumbnail_url"
+	VideoFieldDuration     = "duration"
+	VideoFieldPublishedAt  = "published_at"
+)
+
+// VideoExistsError is returned when a YouTube ID is already stored
+// Deleted = the match is soft-deleted and can be restored instead of re-created
+type VideoExistsError struct {
+	Video   *Video
+	Deleted bool
+}
+
+func (e *VideoExistsError) Error() string {
+	if e.Deleted {
+		return fmt.Sprintf("video with YouTube ID '%s' was deleted (restore it with POST /mod/videos/%s/restore)", e.Video.YoutubeID, e.Video.ID)
+	}
+	return fmt.Sprintf("video with YouTube ID '%s' already exists", e.Video.YoutubeID)
+}
+
+func (e *VideoExistsError) Is(target error) bool {
+	return target == ErrVideoExists
+}
+
+// VideoAvailability is whether the video can still be watched on YouTube
+type VideoAvailability string
+
+const (
+	VideoAvailable   VideoAvailability = "available"
+	VideoUnavailable VideoAvailability = "unavailable" // Deleted, private or rejected on YouTube
+)
+
+// VideoPlaceholderTitleSuffix marks titles created without YouTube metadata (no YOUTUBE_API_KEY)
+const VideoPlaceholderTitleSuffix = "(pending metadata)"
+
 type Video struct {
 	ID            uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
 	YoutubeID     string    `gorm:"type:varchar(20);uniqueIndex;not null"`
@@ -17,6 +64,22 @@ type Video struct {
 	ThumbnailURL  string    `gorm:"type:varchar(500)"`
 	HasTranscript bool      `gorm:"default:false;not null"` // TRUE nếu có ít nhất 1 segment
 
+	// Metadata bổ sung từ YouTube (rỗng với video placeholder)
+	Description          string `gorm:"type:text"`
+	ChannelID            string `gorm:"type:varchar(50);index"`
+	ChannelTitle         string `gorm:"type:varchar(200)"`
+	DefaultAudioLanguage string `gorm:"type:varchar(20)"`       // BCP-47 (vi, en-US), rỗng nếu kênh không khai báo
+	CategoryID           string `gorm:"type:varchar(10);index"` // YouTube category ID (27 = Education)
+	HasCaptions          bool   `gorm:"default:false;not null"` // YouTube có phụ đề (khác HasTranscript của hệ thống)
+
+	// Làm mới metadata định kỳ từ YouTube (nil = chưa làm mới lần nào kể từ khi tạo)
+	MetadataRefreshedAt *time.Time        `gorm:"index"`
+	Availability        VideoAvailability `gorm:"type:varchar(20);not null;default:'available';index"`
+	UnavailableSince    *time.Time        // Lần đầu YouTube báo video bị xóa/riêng tư
+
+	// Các trường mod đã sửa tay (VideoField*), làm mới định kỳ không ghi đè
+	ManualFields []string `gorm:"type:jsonb;serializer:json"`
+
 	// Relationship 1-N: Subtitles
 	Segments []TranscriptSegment `gorm:"foreignKey:VideoID;constraint:OnDelete:CASCADE;"`
 
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sentinel errors for video imports
+var (
+	ErrImportJobNotFound   = errors.New("import job not found")
+	ErrInvalidImport       = errors.New("invalid import request")
+	ErrYouTubeUnavailable  = errors.New("YouTube API not configured (YOUTUBE_API_KEY)")
+	ErrInvalidYouTubeInput = errors.New("invalid YouTube video ID or URL")
+)
+
+// VideoImportSource is what a bulk import reads video IDs from
+type VideoImportSource string
+
+const (
+	VideoImportChannel  VideoImportSource = "channel"  // All uploads of a channel
+	VideoImportPlaylist VideoImportSource = "playlist" // All items of a playlist
+	VideoImportURLs     VideoImportSource = "urls"     // Explicit list of video URLs/IDs
+)
+
+// VideoImportStatus is the lifecycle of an import job
+type VideoImportStatus string
+
+const (
+	VideoImportQueued    VideoImportStatus = "queued"
+	VideoImportRunning   VideoImportStatus = "running"
+	VideoImportCompleted VideoImportStatus = "completed"
+	VideoImportFailed    VideoImportStatus = "failed"
+)
+
+// MaxImportErrors caps the per-video error messages kept on a job
+const MaxImportErrors = 100
+
+// VideoImportJob là một lần import hàng loạt video từ YouTube chạy nền (theo dõi tiến độ qua API)
+type VideoImportJob struct {
+	ID          uuid.UUID         `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Source      VideoImportSource `gorm:"type:varchar(20);not null"`
+	SourceID    string            `gorm:"type:varchar(100)"`          // Channel or playlist ID (empty for urls)
+	VideoIDs    []string          `gorm:"type:jsonb;serializer:json"` // YouTube IDs parsed from the URLs (urls only)
+	MaxVideos   int               `gorm:"not null;default:0"`         // 0 = no limit
+	Status      VideoImportStatus `gorm:"type:varchar(20);not null;index"`
+	RequestedBy uuid.UUID         `gorm:"type:uuid;not null;index"`
+
+	// Tiến độ: Found = số ID đã đọc từ nguồn, đã xử lý = Created + Skipped + Failed
+	Total   int `gorm:"not null;default:0"` // Expected total (playlist size or URL count, capped by MaxVideos)
+	Found   int `gorm:"not null;default:0"`
+	Created int `gorm:"not null;default:0"`
+	Skipped int `gorm:"not null;default:0"` // Already in the database
+	Failed  int `gorm:"not null;default:0"` // Unavailable on YouTube or failed to save
+
+	Errors []string `gorm:"type:jsonb;serializer:json"` // First MaxImportErrors per-video errors
+	Error  string   `gorm:"type:text"`                  // Fatal error that stopped the job
+
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	CreatedAt  time.Time `gorm:"index"`
+	UpdatedAt  time.Time
+}
+
+func (VideoImportJob) TableName() string {
+	return "video_import_jobs"
+}
+
+// AddError records a per-video failure (keeps the first MaxImportErrors messages)
+func (j *VideoImportJob) AddError(message string) {
+	j.Failed++
+	if len(j.Errors) < MaxImportErrors {
+		j.Errors = append(j.Errors, message)
+	}
+}
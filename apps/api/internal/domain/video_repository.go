@@ -2,6 +2,8 @@ package domain
 
 import (
 	"api/internal/dto"
+	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -12,14 +14,36 @@ type VideoRepository interface {
 	GetModVideoList(offset, limit int, searchQuery, tagIDsStr, hasTranscriptStr string) ([]Video, int64, error)
 	GetVideoByID(id uuid.UUID) (*Video, error)
 	GetVideoByYoutubeID(youtubeID string) (*Video, error)
+	FindVideoByYoutubeIDWithDeleted(youtubeID string) (*Video, error) // nil when absent
 	GetVideoTranscript(videoID uuid.UUID) ([]TranscriptSegment, error)
 	UpdateSegment(id uint, textContent string) (*TranscriptSegment, error)
 	CreateSegment(videoID uuid.UUID, startTime, endTime int, text string) (*TranscriptSegment, error)
 	Create(video *Video) error
 	Update(video *Video) error
-	Delete(id uuid.UUID) error // Soft delete
+	UpdateVideoMetadata(ctx context.Context, id uuid.UUID, expectedUpdatedAt time.Time, updates map[string]interface{}, manualFields []string) error // ErrVideoModified if updated_at changed
+	Delete(id uuid.UUID) error                                                                                                                       // Soft delete
+	Restore(id uuid.UUID) error                                                                                                                      // Undo soft delete
 	SearchVideos(query string, page, limit int) ([]Video, int64, error)
 	GetReviewCountsForVideos(videoIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	GetTagDisplayNames(tagIDs []uuid.UUID) (map[uuid.UUID]map[string]string, error) // tagID → locale → name
+
+	// Bulk import jobs
+	CreateImportJob(ctx context.Context, job *VideoImportJob) error
+	SaveImportJobProgress(ctx context.Context, job *VideoImportJob) error
+	GetImportJob(ctx context.Context, id uuid.UUID) (*VideoImportJob, error)
+	ListImportJobs(ctx context.Context, page, limit int) ([]VideoImportJob, int64, error)
+	FailInterruptedImportJobs(ctx context.Context) (int64, error)                            // Jobs left running by a previous process
+	GetExistingYoutubeIDs(ctx context.Context, youtubeIDs []string) (map[string]bool, error) // Includes soft-deleted videos
+	CreateVideoIfAbsent(ctx context.Context, video *Video) (bool, error)                     // false = YouTube ID already exists
+
+	// Trash (soft-deleted videos)
+	ListDeletedVideos(ctx context.Context, page, limit int) ([]Video, int64, error)
+	GetVideoIDsDeletedBefore(ctx context.Context, before time.Time, limit int) ([]uuid.UUID, error)
+	PurgeVideos(ctx context.Context, ids []uuid.UUID) (int64, error) // Hard delete with segments, tag links and reviews; live videos ignored
+
+	// Scheduled metadata refresh
+	GetVideosForMetadataRefresh(ctx context.Context, staleBefore time.Time, limit int) ([]Video, error) // Placeholders first, then stalest
+	ApplyMetadataRefresh(ctx context.Context, refreshed []Video, unavailableIDs []uuid.UUID, refreshedAt time.Time) error
 
 	// Search operations
 	SearchTranscripts(query string, limit int) ([]dto.TranscriptSearchResult, error)
@@ -2,11 +2,15 @@ package domain
 
 import (
 	"api/internal/dto"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 type VideoService interface {
 	GetVideoList(req dto.ListVideoRequest) (*dto.VideoListResponse, error)
-	GetVideoDetail(id string) (*dto.VideoDetailResponse, error)
+	GetVideoDetail(id string, locales []string) (*dto.VideoDetailResponse, error) // locales: tag name preference (nil = default names)
 	GetVideoTranscript(id string) (*dto.TranscriptResponse, error)
 	UpdateSegment(id uint, req dto.UpdateSegmentRequest) (*dto.SegmentResponse, error)
 	CreateSegment(videoID string, req dto.CreateSegmentRequest) (*dto.SegmentResponse, error)
@@ -17,6 +21,22 @@ type VideoService interface {
 	GetModVideoList(page, pageSize int, searchQuery, tagIDsStr, hasTranscriptStr string) ([]dto.ModVideoResponse, int64, error)
 	CreateVideo(req dto.CreateVideoRequest) (*dto.VideoCreateResponse, error)
 	PreviewYouTubeVideo(youtubeID string) (*dto.VideoCreateResponse, error)
+	UpdateVideo(ctx context.Context, id string, req dto.UpdateVideoRequest) (*dto.ModVideoResponse, error) // Optimistic on updated_at, locks edited fields
 	DeleteVideo(id string) error
+	RestoreVideo(id string) (*dto.VideoCreateResponse, error) // Undo a soft delete
 	SearchVideos(query string, page, limit int) (*dto.VideoListResponse, error)
+
+	// Bulk import (Mod) - runs as a background job
+	StartVideoImport(ctx context.Context, req dto.CreateVideoImportRequest, requestedBy uuid.UUID) (*dto.VideoImportJobResponse, error)
+	GetVideoImport(ctx context.Context, id string) (*dto.VideoImportJobResponse, error)
+	ListVideoImports(ctx context.Context, page, limit int) (*dto.VideoImportJobListResponse, error)
+	FailInterruptedVideoImports(ctx context.Context) error // Startup: jobs cannot resume after a restart
+
+	// Trash (Admin) - restore is RestoreVideo
+	ListTrashedVideos(ctx context.Context, page, limit int) (*dto.TrashedVideoListResponse, error)
+	PurgeVideo(ctx context.Context, id string) error
+	PurgeExpiredVideos(ctx context.Context, retention time.Duration) (int, error) // Scheduled job: purge videos deleted longer ago than retention
+
+	// Scheduled job: re-fetch YouTube metadata (placeholders and stale videos first), returns videos checked
+	RefreshVideoMetadata(ctx context.Context) (int, error)
 }
@@ -16,10 +16,17 @@ type UpdateTagRequest struct {
 
 // TagResponse - Tag data for API responses
 type TagResponse struct {
-	ID         string   `json:"id"`
-	Name       string   `json:"name"`
-	IsApproved bool     `json:"is_approved"`
-	Aliases    []string `json:"aliases,omitempty"` // List of alias names for display
+	ID                string              `json:"id"`
+	Name              string              `json:"name"`
+	Slug              string              `json:"slug,omitempty"`
+	IsApproved        bool                `json:"is_approved"`
+	ParentID          *string             `json:"parent_id,omitempty"`           // Parent in the topic tree (nil = root)
+	VideoCount        int64               `json:"video_count"`                   // Videos tagged with this tag
+	AliasCount        int64               `json:"alias_count"`                   // Aliases pointing to this tag
+	Aliases           []string            `json:"aliases,omitempty"`             // List of alias names for display
+	AliasesByLanguage map[string][]string `json:"aliases_by_language,omitempty"` // Same aliases keyed by language (vi, en, ..., unk)
+	Locale            string              `json:"locale,omitempty"`              // Locale of name ("" = default display name)
+	DisplayNames      map[string]string   `json:"display_names,omitempty"`       // Localized names by locale (single tag responses)
 }
 
 // CanonicalTagResponse - Canonical tag response with alias metadata
@@ -43,6 +50,22 @@ type TagListRequest struct {
 	Limit        int    `form:"limit" binding:"omitempty,min=1,max=100" default:"20"`
 	Query        string `form:"query" binding:"omitempty"`
 	ApprovedOnly bool   `form:"approved_only" default:"false"`
+	Tree         bool   `form:"tree" default:"false"` // Return the topic tree with video counts instead of a page
+	Sort         string `form:"sort" binding:"omitempty,oneof=name popular growing" default:"name"`
+	Days         int    `form:"days" binding:"omitempty,min=1,max=90" default:"7"` // Growth window for sort=growing
+}
+
+// TrendingTagsRequest - Request params for trending tags
+type TrendingTagsRequest struct {
+	Days         int  `form:"days" binding:"omitempty,min=1,max=90" default:"7"` // Window: videos tagged in the last N days
+	Limit        int  `form:"limit" binding:"omitempty,min=1,max=100" default:"20"`
+	ApprovedOnly bool `form:"approved_only" default:"false"`
+}
+
+// TrendingTagResponse - A tag with the number of videos tagged within the window
+type TrendingTagResponse struct {
+	Tag              TagResponse `json:"tag"`
+	RecentVideoCount int64       `json:"recent_video_count"` // Videos tagged within the window
 }
 
 // TagListResponse - Response with list of tags and pagination
@@ -64,6 +87,80 @@ type RemoveVideoTagRequest struct {
 	TagID string `json:"tag_id" binding:"required"`
 }
 
+// BulkVideoTagRequest - Apply every tag to every video
+// A tag is either a canonical tag ID (UUID) or a name resolved like POST /videos/:id/tags
+type BulkVideoTagRequest struct {
+	VideoIDs []string `json:"video_ids" binding:"required,min=1,max=500,dive,uuid"`
+	Tags     []string `json:"tags" binding:"required,min=1,max=50,dive,min=1,max=100" example:"Machine Learning"`
+}
+
+// VideoTagAssignment - Tags to apply to one video (used by bulk tagging and CSV import)
+type VideoTagAssignment struct {
+	VideoID string
+	Tags    []string
+}
+
+// Bulk tagging statuses
+const (
+	BulkTagStatusAdded         = "added"          // Link created
+	BulkTagStatusAlreadyTagged = "already_tagged" // Video already had the tag
+	BulkTagStatusFailed        = "failed"         // Video or tag could not be resolved
+)
+
+// BulkTagResolution - How one distinct tag input was resolved
+type BulkTagResolution struct {
+	Input string       `json:"input"`
+	Tag   *TagResponse `json:"tag,omitempty"`
+	IsNew bool         `json:"is_new"`          // A new canonical tag was created
+	Error string       `json:"error,omitempty"` // Set when the input could not be resolved
+}
+
+// BulkVideoTagItem - Result for one (video, tag input) pair
+type BulkVideoTagItem struct {
+	VideoID string `json:"video_id"`
+	Input   string `json:"input"`
+	TagID   string `json:"tag_id,omitempty"`
+	Status  string `json:"status"` // added, already_tagged, failed
+	Error   string `json:"error,omitempty"`
+}
+
+// BulkVideoTagResponse - Per-tag resolutions and per-item results of a bulk tagging run
+type BulkVideoTagResponse struct {
+	Tags         []BulkTagResolution `json:"tags"`
+	Items        []BulkVideoTagItem  `json:"items"`
+	AddedCount   int                 `json:"added_count"`
+	SkippedCount int                 `json:"skipped_count"` // already_tagged
+	FailedCount  int                 `json:"failed_count"`
+}
+
+// ResolveTagBatchRequest - Resolve many tag names at once, optionally tagging a video with all of them
+type ResolveTagBatchRequest struct {
+	Names   []string `json:"names" binding:"required,min=1,max=50,dive,min=1,max=100" example:"Machine Learning,Học máy"`
+	VideoID *string  `json:"video_id,omitempty" binding:"omitempty,uuid"` // Link every resolved tag to this video (one transaction)
+}
+
+// TagBatchResolution - How one input of a batch was resolved
+type TagBatchResolution struct {
+	Input          string       `json:"input"`
+	NormalizedText string       `json:"normalized_text"`
+	Layer          string       `json:"layer,omitempty"`           // "1" | "1.5" | "3" | "4"
+	Decision       string       `json:"decision,omitempty"`        // exact_match|translation_match|auto_merge|create_new_suggested|create_new|create_new_no_embedding
+	TranslatedText *string      `json:"translated_text,omitempty"` // Layer 1.5 output (nil if skipped/failed)
+	Tag            *TagResponse `json:"tag,omitempty"`
+	IsNew          bool         `json:"is_new"`                // A new canonical tag was created
+	LinkStatus     string       `json:"link_status,omitempty"` // added|already_tagged when video_id is set
+	Error          string       `json:"error,omitempty"`       // Set when the input could not be resolved
+}
+
+// ResolveTagBatchResponse - Per-input results of a batch resolution (same order as the request)
+type ResolveTagBatchResponse struct {
+	Results       []TagBatchResolution `json:"results"`
+	ResolvedCount int                  `json:"resolved_count"`
+	CreatedCount  int                  `json:"created_count"` // New canonical tags
+	FailedCount   int                  `json:"failed_count"`
+	LinkedCount   int                  `json:"linked_count"` // New video links (video_id only)
+}
+
 // ============ Tag Merge DTOs ============
 
 // MergeTagsRequest - Request to manually merge source tag into target tag
@@ -78,6 +175,291 @@ type MergeTagsResponse struct {
 	TargetTag        TagResponse `json:"target_tag"`         // The canonical tag that remains
 	MergedAliasCount int         `json:"merged_alias_count"` // Number of aliases moved
 	SourceTagDeleted bool        `json:"source_tag_deleted"` // Whether source canonical was deleted
+	MergeID          uint        `json:"merge_id"`           // Merge log ID (use with /merges/:id/undo)
+	MergedVideoCount int         `json:"merged_video_count"` // Videos of source now linked to target
+}
+
+// DeleteTagResponse - Response after deleting a canonical tag
+type DeleteTagResponse struct {
+	DeletedTagID       string       `json:"deleted_tag_id"`
+	ReassignedTo       *TagResponse `json:"reassigned_to,omitempty"` // Set when videos/aliases were moved via merge
+	AffectedVideoCount int          `json:"affected_video_count"`    // Videos unlinked (or reassigned)
+	AffectedAliasCount int          `json:"affected_alias_count"`    // Aliases deleted (or reassigned)
+	MergeID            *uint        `json:"merge_id,omitempty"`      // Merge log ID when reassigned (undoable)
+}
+
+// UndoMergeResponse - Response after undoing a merge
+type UndoMergeResponse struct {
+	MergeID            uint        `json:"merge_id"`
+	RestoredTag        TagResponse `json:"restored_tag"`         // Source tag, recreated with its original ID
+	TargetTag          TagResponse `json:"target_tag"`           // Tag the source had been merged into
+	RestoredAliasCount int         `json:"restored_alias_count"` // Aliases moved back to source
+	RestoredVideoCount int         `json:"restored_video_count"` // Video links restored on source
+	RemovedTargetLinks int         `json:"removed_target_links"` // Target links that only existed because of the merge
+}
+
+// ============ Tag Resolve Dry-Run DTOs ============
+
+// TagResolveTraceResponse - Explains every ResolveTag layer without side effects
+type TagResolveTraceResponse struct {
+	Input          string                 `json:"input"`
+	NormalizedText string                 `json:"normalized_text"`
+	TranslatedText *string                `json:"translated_text,omitempty"` // Layer 1.5 output (nil if skipped/failed)
+	NearestAliases []NearestAliasResponse `json:"nearest_aliases"`           // Top-K aliases by vector distance
+	Threshold      float64                `json:"threshold"`                 // Distance threshold applied in Layer 3
+	MatchStrategy  string                 `json:"match_strategy"`            // How Layer 3 measures the distance to a canonical
+	Decision       string                 `json:"decision"`                  // exact_match|translation_match|auto_merge|create_new_suggested|create_new|create_new_no_embedding|unavailable
+	DecisionTag    *TagResponse           `json:"decision_tag,omitempty"`    // Existing canonical that would be used (nil if a new one would be created)
+	Layers         []TagResolveLayerTrace `json:"layers"`                    // Step-by-step reasoning
+	DryRun         bool                   `json:"dry_run"`
+}
+
+// TagResolveLayerTrace - Outcome of a single resolution layer
+type TagResolveLayerTrace struct {
+	Layer  string `json:"layer"`            // "1" | "1.5" | "2" | "3" | "4"
+	Name   string `json:"name"`             // Human-readable layer name
+	Result string `json:"result"`           // hit | miss | skipped | error | decision
+	Detail string `json:"detail,omitempty"` // Explanation for mods
+}
+
+// NearestAliasResponse - An alias close to the input embedding
+type NearestAliasResponse struct {
+	AliasID         string  `json:"alias_id"`
+	Alias           string  `json:"alias"`
+	CanonicalID     string  `json:"canonical_id"`
+	CanonicalName   string  `json:"canonical_name"`
+	Distance        float64 `json:"distance"`         // Cosine distance (lower = closer)
+	Similarity      float64 `json:"similarity"`       // 1 - distance/2
+	WithinThreshold bool    `json:"within_threshold"` // distance < threshold (would auto-merge)
+}
+
+// ============ Tag Alias Management DTOs ============
+
+// TagAliasResponse - Alias data with admin review metadata
+type TagAliasResponse struct {
+	ID              string    `json:"id"`
+	CanonicalTagID  string    `json:"canonical_tag_id"`
+	RawText         string    `json:"raw_text"`
+	NormalizedText  string    `json:"normalized_text"`
+	Language        string    `json:"language"`
+	IsReviewed      bool      `json:"is_reviewed"`      // false = AI auto-mapped
+	SimilarityScore float64   `json:"similarity_score"` // 0.0 to 1.0 (1.0 = exact/initial alias)
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// MoveAliasRequest - Request to move an alias to another canonical tag
+type MoveAliasRequest struct {
+	TargetID string `json:"target_id" binding:"required,uuid"`
+}
+
+// SplitAliasRequest - Request to split an alias out into its own canonical tag
+type SplitAliasRequest struct {
+	DisplayName string `json:"display_name" binding:"omitempty,max=100"` // Defaults to alias raw text
+}
+
+// UpdateAliasReviewRequest - Request to set alias review status
+type UpdateAliasReviewRequest struct {
+	IsReviewed bool `json:"is_reviewed"`
+}
+
+// ============ Alias Review Queue DTOs ============
+
+// AliasReviewQueueItem - One unreviewed alias with context for the reviewer
+type AliasReviewQueueItem struct {
+	Alias              TagAliasResponse             `json:"alias"`
+	CurrentTag         TagResponse                  `json:"current_tag"`          // Canonical the alias is attached to
+	Alternatives       []CanonicalCandidateResponse `json:"alternatives"`         // Nearest other canonicals
+	AffectedVideoCount int64                        `json:"affected_video_count"` // Videos linked to current canonical
+	AffectedVideos     []VideoRefResponse           `json:"affected_videos"`      // Sample of affected videos
+}
+
+// AliasReviewQueueResponse - Paginated review queue
+type AliasReviewQueueResponse struct {
+	Data       []AliasReviewQueueItem `json:"data"`
+	Pagination PaginationMetadata     `json:"pagination"`
+}
+
+// CanonicalCandidateResponse - A canonical tag close to a given embedding
+type CanonicalCandidateResponse struct {
+	CanonicalID   string  `json:"canonical_id"`
+	CanonicalName string  `json:"canonical_name"`
+	Distance      float64 `json:"distance"`
+	Similarity    float64 `json:"similarity"`
+}
+
+// VideoRefResponse - Minimal video reference
+type VideoRefResponse struct {
+	ID        string `json:"id"`
+	YoutubeID string `json:"youtube_id"`
+	Title     string `json:"title"`
+}
+
+// AliasReviewerStatsResponse - Review throughput of a single mod
+type AliasReviewerStatsResponse struct {
+	ReviewerID     string    `json:"reviewer_id"`
+	Username       string    `json:"username"`
+	Accepted       int64     `json:"accepted"`
+	Reassigned     int64     `json:"reassigned"`
+	Split          int64     `json:"split"`
+	Total          int64     `json:"total"`
+	LastReviewedAt time.Time `json:"last_reviewed_at"`
+}
+
+// AliasReviewStatsResponse - Review queue throughput per mod
+type AliasReviewStatsResponse struct {
+	Since        time.Time                    `json:"since"`
+	PendingCount int64                        `json:"pending_count"` // Aliases still waiting for review
+	Reviewers    []AliasReviewerStatsResponse `json:"reviewers"`
+}
+
+// ============ Tag Rename DTOs ============
+
+// RenameTagRequest - Request to rename a canonical tag
+type RenameTagRequest struct {
+	Name string `json:"name" binding:"required,min=1,max=100" example:"Artificial Intelligence"`
+}
+
+// RenameTagResponse - Response after renaming a canonical tag
+type RenameTagResponse struct {
+	Tag     TagResponse `json:"tag"`
+	OldName string      `json:"old_name"`
+	OldSlug string      `json:"old_slug"` // Still resolves via /tags/by-slug/{slug}
+}
+
+// TagBySlugResponse - Tag resolved from a current or historic slug
+type TagBySlugResponse struct {
+	Tag        TagResponse `json:"tag"`
+	Redirected bool        `json:"redirected"` // true if the requested slug is historic; use tag.slug
+}
+
+// ============ Localized Display Names DTOs ============
+
+// PromoteAliasDisplayNameRequest - Use an existing alias as the display name of a locale
+// locale defaults to the alias language
+type PromoteAliasDisplayNameRequest struct {
+	AliasID string `json:"alias_id" binding:"required,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Locale  string `json:"locale" binding:"omitempty,min=2,max=10" example:"vi"`
+}
+
+// ============ Related Tags DTOs ============
+
+// RelatedTagResponse - A "see also" tag with its relatedness evidence
+type RelatedTagResponse struct {
+	Tag                 TagResponse `json:"tag"`
+	Score               float64     `json:"score"`                          // 0-1, higher = more related
+	CoOccurrenceCount   int64       `json:"co_occurrence_count"`            // Videos tagged with both tags
+	Jaccard             float64     `json:"jaccard"`                        // Co-occurrence Jaccard index
+	EmbeddingSimilarity *float64    `json:"embedding_similarity,omitempty"` // Centroid similarity (0-1)
+}
+
+// RelatedTagsRefreshResponse - Result of a manual related tags refresh
+type RelatedTagsRefreshResponse struct {
+	RelationCount int `json:"relation_count"` // Relations stored (both directions)
+}
+
+// ============ Tag Taxonomy DTOs ============
+
+// TaxonomyFile - Versioned taxonomy export (also the import body)
+// Tags reference each other by slug so the file is portable between environments
+type TaxonomyFile struct {
+	Format     string            `json:"format" binding:"required" example:"ttt-tag-taxonomy"`
+	Version    int               `json:"version" binding:"required" example:"1"`
+	ExportedAt time.Time         `json:"exported_at"`
+	Tags       []TaxonomyTagItem `json:"tags"`
+}
+
+// TaxonomyTagItem - A canonical tag in a taxonomy file
+type TaxonomyTagItem struct {
+	Slug        string              `json:"slug" example:"machine-learning"`
+	DisplayName string              `json:"display_name" example:"Machine Learning"`
+	IsApproved  bool                `json:"is_approved"`
+	ParentSlug  string              `json:"parent_slug,omitempty" example:"artificial-intelligence"` // Empty = root
+	Aliases     []TaxonomyAliasItem `json:"aliases"`
+}
+
+// TaxonomyAliasItem - An alias in a taxonomy file
+type TaxonomyAliasItem struct {
+	Text       string    `json:"text" example:"Học máy"`
+	Language   string    `json:"language" example:"vi"`
+	IsReviewed bool      `json:"is_reviewed"`
+	Embedding  []float32 `json:"embedding,omitempty"` // Optional; regenerated on import when missing
+}
+
+// TaxonomyConflictResponse - Part of the file that was not applied
+type TaxonomyConflictResponse struct {
+	Kind         string `json:"kind" example:"alias_elsewhere"`
+	Slug         string `json:"slug"`
+	Alias        string `json:"alias,omitempty"`
+	ExistingSlug string `json:"existing_slug,omitempty"` // Current owner of the alias / missing parent
+	Message      string `json:"message"`
+}
+
+// TaxonomyImportResponse - Result of a taxonomy import (or dry run)
+type TaxonomyImportResponse struct {
+	DryRun              bool                       `json:"dry_run"`
+	TagsCreated         int                        `json:"tags_created"`
+	TagsUpdated         int                        `json:"tags_updated"`
+	TagsUnchanged       int                        `json:"tags_unchanged"`
+	AliasesCreated      int                        `json:"aliases_created"`
+	AliasesUnchanged    int                        `json:"aliases_unchanged"`
+	ParentsSet          int                        `json:"parents_set"`
+	EmbeddingsGenerated int                        `json:"embeddings_generated"`
+	EmbeddingsMissing   int                        `json:"embeddings_missing"` // Aliases left without embedding (excluded from semantic search)
+	Conflicts           []TaxonomyConflictResponse `json:"conflicts"`
+}
+
+// ============ Tag Hierarchy DTOs ============
+
+// SetTagParentRequest - Move a tag (with its subtree) under another tag
+// parent_id = null moves the tag to the root
+type SetTagParentRequest struct {
+	ParentID *string `json:"parent_id" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// TagTreeNodeResponse - A node of the topic tree
+type TagTreeNodeResponse struct {
+	ID              string                `json:"id"`
+	Name            string                `json:"name"`
+	Slug            string                `json:"slug"`
+	IsApproved      bool                  `json:"is_approved"`
+	VideoCount      int64                 `json:"video_count"`       // Videos tagged directly
+	TotalVideoCount int64                 `json:"total_video_count"` // Distinct videos in the whole subtree
+	Children        []TagTreeNodeResponse `json:"children"`
+}
+
+// ============ Duplicate Detection DTOs ============
+
+// TagDuplicateCandidateResponse - A candidate pair of duplicate canonical tags
+type TagDuplicateCandidateResponse struct {
+	ID                uint               `json:"id"`
+	TagA              TagResponse        `json:"tag_a"`
+	TagB              TagResponse        `json:"tag_b"`
+	Source            string             `json:"source"` // scan | resolve
+	Score             float64            `json:"score"`
+	EmbeddingDistance *float64           `json:"embedding_distance,omitempty"`
+	SlugSimilarity    float64            `json:"slug_similarity"`
+	TrigramSimilarity float64            `json:"trigram_similarity"`
+	MatchedAliases    []string           `json:"matched_aliases"`
+	CoOccurrenceCount int64              `json:"co_occurrence_count"`
+	CoOccurringVideos []VideoRefResponse `json:"co_occurring_videos"`
+	DetectedAt        time.Time          `json:"detected_at"`
+}
+
+// TagDuplicateListResponse - Paginated duplicate candidates
+type TagDuplicateListResponse struct {
+	Data       []TagDuplicateCandidateResponse `json:"data"`
+	Pagination PaginationMetadata              `json:"pagination"`
+}
+
+// AcceptDuplicateRequest - Optional merge direction when accepting a duplicate pair
+// If empty, the approved (or older) tag is kept
+type AcceptDuplicateRequest struct {
+	TargetID string `json:"target_id" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// DuplicateScanResponse - Result of a manual duplicate scan
+type DuplicateScanResponse struct {
+	PendingCount int `json:"pending_count"`
 }
 
 // ============ Tag Approve DTOs ============
@@ -91,7 +473,7 @@ type UpdateTagApprovalRequest struct {
 
 // CreateVideoRequest - Request to create a video from YouTube
 type CreateVideoRequest struct {
-	YoutubeID string `json:"youtube_id" binding:"required,min=11,max=11"`
+	YoutubeID string `json:"youtube_id" binding:"required,min=11,max=500" example:"https://youtu.be/dQw4w9WgXcQ?t=42"` // Video ID or any YouTube video URL
 }
 
 // YouTubeVideoInfo - YouTube video metadata
@@ -102,6 +484,7 @@ type YouTubeVideoInfo struct {
 	Duration     int       `json:"duration"` // seconds
 	ViewCount    int       `json:"view_count"`
 	ThumbnailURL string    `json:"thumbnail_url"`
+	VideoMetadataResponse
 }
 
 // VideoCreateResponse - Response after creating a video
@@ -115,4 +498,101 @@ type VideoCreateResponse struct {
 	ThumbnailURL  string    `json:"thumbnail_url"`
 	HasTranscript bool      `json:"has_transcript"`
 	CreatedAt     time.Time `json:"created_at"`
+	VideoMetadataResponse
+
+	// Preview only: the video is already stored (deleted = soft-deleted, restore instead of creating)
+	ExistingVideoID string `json:"existing_video_id,omitempty"`
+	ExistingDeleted bool   `json:"existing_deleted,omitempty"`
+}
+
+// VideoDuplicateResponse - 409 when the YouTube video is already stored
+type VideoDuplicateResponse struct {
+	ErrorResponse
+	ExistingVideo VideoCardResponse `json:"existing_video"`
+	Deleted       bool              `json:"deleted"`                // Soft-deleted: restore instead of creating
+	RestorePath   string            `json:"restore_path,omitempty"` // POST to restore (deleted only)
+}
+
+// CreateTagProposalRequest - A user proposes a tag for a video
+type CreateTagProposalRequest struct {
+	TagName string `json:"tag_name" binding:"required,min=1,max=100" example:"Machine Learning"`
+}
+
+// ApproveTagProposalRequest - Optional overrides when approving a proposal
+type ApproveTagProposalRequest struct {
+	TagID      *string `json:"tag_id,omitempty" binding:"omitempty,uuid"` // Link this canonical instead of resolving the proposed name
+	ApproveTag bool    `json:"approve_tag"`                               // Also mark the canonical tag approved
+}
+
+// RejectTagProposalRequest - Reason shown to the proposer
+type RejectTagProposalRequest struct {
+	Reason string `json:"reason" binding:"required,min=1,max=500" example:"Too generic for this video"`
+}
+
+// TagProposalResponse - A user tag proposal and its review state
+type TagProposalResponse struct {
+	ID               string            `json:"id"`
+	Video            *VideoRefResponse `json:"video,omitempty"` // nil if the video was deleted
+	ProposerID       string            `json:"proposer_id"`
+	ProposerUsername string            `json:"proposer_username,omitempty"`
+	TagName          string            `json:"tag_name"`
+	Status           string            `json:"status"`                     // pending, approved, rejected
+	Tag              *TagResponse      `json:"tag,omitempty"`              // Linked canonical tag (approved only)
+	RejectionReason  string            `json:"rejection_reason,omitempty"` // rejected only
+	ReviewedBy       *string           `json:"reviewed_by,omitempty"`
+	ReviewedAt       *time.Time        `json:"reviewed_at,omitempty"`
+	CreatedAt        time.Time         `json:"created_at"`
+}
+
+// TagProposalListResponse - Paginated tag proposals
+type TagProposalListResponse struct {
+	Data       []TagProposalResponse `json:"data"`
+	Pagination PaginationMetadata    `json:"pagination"`
+}
+
+// UpdateResolutionPolicyRequest - Change tag resolution settings (omitted fields are unchanged)
+type UpdateResolutionPolicyRequest struct {
+	AutoMergeDistance      *float64           `json:"auto_merge_distance,omitempty" example:"0.4"`                        // Cosine distance (0, 2]; 0.40 = 80% similarity
+	LanguageDistances      map[string]float64 `json:"language_distances,omitempty"`                                       // Per-language override, replaces all overrides ({} clears)
+	MatchStrategy          *string            `json:"match_strategy,omitempty" example:"hybrid"`                          // alias_min | centroid | hybrid
+	MergeMode              *string            `json:"merge_mode,omitempty" example:"auto_merge"`                          // auto_merge | suggest
+	TranslationEnabled     *bool              `json:"translation_enabled,omitempty"`                                      // Layer 1.5 on/off
+	AutoApproveAliases     *bool              `json:"auto_approve_aliases,omitempty"`                                     // AI-mapped aliases skip the review queue
+	CreateWithoutEmbedding *bool              `json:"create_without_embedding,omitempty"`                                 // Create canonicals when OpenAI is unavailable
+	Reason                 string             `json:"reason,omitempty" binding:"max=500" example:"Too many wrong merges"` // Stored in the audit trail
+}
+
+// ResolutionPolicySettingsResponse - Tag resolution settings
+type ResolutionPolicySettingsResponse struct {
+	AutoMergeDistance      float64            `json:"auto_merge_distance"`
+	AutoMergeSimilarity    float64            `json:"auto_merge_similarity"` // 1 - distance/2
+	LanguageDistances      map[string]float64 `json:"language_distances"`
+	MatchStrategy          string             `json:"match_strategy"` // alias_min | centroid | hybrid
+	MergeMode              string             `json:"merge_mode"`
+	TranslationEnabled     bool               `json:"translation_enabled"`
+	AutoApproveAliases     bool               `json:"auto_approve_aliases"`
+	CreateWithoutEmbedding bool               `json:"create_without_embedding"`
+}
+
+// ResolutionPolicyResponse - Current tag resolution policy
+type ResolutionPolicyResponse struct {
+	ResolutionPolicySettingsResponse
+	UpdatedBy *string    `json:"updated_by,omitempty"` // nil = defaults, never changed
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// ResolutionPolicyChangeResponse - One entry of the policy audit trail
+type ResolutionPolicyChangeResponse struct {
+	ID        uint                             `json:"id"`
+	ChangedBy string                           `json:"changed_by"`
+	Before    ResolutionPolicySettingsResponse `json:"before"`
+	After     ResolutionPolicySettingsResponse `json:"after"`
+	Reason    string                           `json:"reason,omitempty"`
+	CreatedAt time.Time                        `json:"created_at"`
+}
+
+// ResolutionPolicyChangeListResponse - Paginated policy audit trail
+type ResolutionPolicyChangeListResponse struct {
+	Data       []ResolutionPolicyChangeResponse `json:"data"`
+	Pagination PaginationMetadata               `json:"pagination"`
 }
@@ -1,14 +1,17 @@
 package dto
 
+import "time"
+
 // ListVideoRequest - Request params for listing videos
 type ListVideoRequest struct {
-	Page          int    `form:"page" binding:"omitempty,min=1" default:"1"`
-	Limit         int    `form:"limit" binding:"omitempty,min=1,max=50" default:"10"`
-	Sort          string `form:"sort" binding:"omitempty,oneof=newest popular views"`
-	TagID         string `form:"tag_id" binding:"omitempty,uuid"`
-	HasTranscript *bool  `form:"has_transcript" binding:"omitempty"` // nil = all, true = only with transcript, false = only without
-	IsReviewed    *bool  `form:"is_reviewed" binding:"omitempty"`    // nil = all, true = only reviewed, false = only not reviewed
-	Q             string `form:"q" binding:"omitempty"`              // Search query - searches in Title OR Tag Name
+	Page               int    `form:"page" binding:"omitempty,min=1" default:"1"`
+	Limit              int    `form:"limit" binding:"omitempty,min=1,max=50" default:"10"`
+	Sort               string `form:"sort" binding:"omitempty,oneof=newest popular views"`
+	TagID              string `form:"tag_id" binding:"omitempty,uuid"`
+	IncludeDescendants bool   `form:"include_descendants"`                // With tag_id: also match videos tagged with descendant tags
+	HasTranscript      *bool  `form:"has_transcript" binding:"omitempty"` // nil = all, true = only with transcript, false = only without
+	IsReviewed         *bool  `form:"is_reviewed" binding:"omitempty"`    // nil = all, true = only reviewed, false = only not reviewed
+	Q                  string `form:"q" binding:"omitempty"`              // Search query - searches in Title, Description OR Tag Name
 }
 
 // VideoCardResponse - Lightweight video data for grid/list view
@@ -27,9 +30,20 @@ type VideoCardResponse struct {
 // VideoDetailResponse - Full video data with tags
 type VideoDetailResponse struct {
 	VideoCardResponse
+	VideoMetadataResponse
 	Tags []TagResponse `json:"tags"`
 }
 
+// VideoMetadataResponse - Extra YouTube metadata (empty for videos created without YOUTUBE_API_KEY)
+type VideoMetadataResponse struct {
+	Description          string `json:"description"`
+	ChannelID            string `json:"channel_id"`
+	ChannelTitle         string `json:"channel_title"`
+	DefaultAudioLanguage string `json:"default_audio_language,omitempty"` // BCP-47, set by the channel
+	CategoryID           string `json:"category_id,omitempty"`            // YouTube category ID (27 = Education)
+	HasCaptions          bool   `json:"has_captions"`                     // Captions exist on YouTube
+}
+
 // TranscriptResponse - Full transcript with segments
 type TranscriptResponse struct {
 	VideoID  string            `json:"video_id"`
@@ -97,7 +111,6 @@ type ModVideoResponse struct {
 	ID            string        `json:"id"`
 	YoutubeID     string        `json:"youtube_id"`
 	Title         string        `json:"title"`
-	Description   string        `json:"description"`
 	ThumbnailURL  string        `json:"thumbnail_url"`
 	Duration      int           `json:"duration"`
 	PublishedAt   string        `json:"published_at"`
@@ -107,6 +120,29 @@ type ModVideoResponse struct {
 	Tags          []TagResponse `json:"tags"`
 	CreatedAt     string        `json:"created_at"`
 	UpdatedAt     string        `json:"updated_at"`
+	VideoMetadataResponse
+
+	ManualFields []string `json:"manual_fields"` // Fields edited by a mod, kept by the metadata refresh
+
+	Availability        string     `json:"availability"`                    // available | unavailable (deleted/private on YouTube)
+	UnavailableSince    *time.Time `json:"unavailable_since,omitempty"`     // First refresh that found the video unavailable
+	MetadataRefreshedAt *time.Time `json:"metadata_refreshed_at,omitempty"` // Last YouTube metadata refresh (nil = never)
+}
+
+// UpdateVideoRequest - Mod edit of video metadata; only the fields set are changed
+// Edited fields are locked so the scheduled YouTube refresh keeps them (unlock_fields releases them)
+type UpdateVideoRequest struct {
+	// updated_at from the last read; rejected with 409 if the video changed since
+	UpdatedAt time.Time `json:"updated_at" binding:"required"`
+
+	Title        *string `json:"title,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	ThumbnailURL *string `json:"thumbnail_url,omitempty"`
+	Duration     *int    `json:"duration,omitempty"`                          // Seconds
+	PublishedAt  *string `json:"published_at,omitempty" example:"2024-05-01"` // YYYY-MM-DD
+
+	// Let the refresh overwrite these fields again
+	UnlockFields []string `json:"unlock_fields,omitempty" binding:"omitempty,dive,oneof=title description thumbnail_url duration published_at"`
 }
 
 // ModVideoListResponse - Paginated mod video list
@@ -116,3 +152,49 @@ type ModVideoListResponse struct {
 	Page     int                `json:"page"`
 	PageSize int                `json:"page_size"`
 }
+
+// TrashedVideoResponse - A soft-deleted video in the admin trash
+type TrashedVideoResponse struct {
+	VideoCardResponse
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// TrashedVideoListResponse - Paginated admin trash
+type TrashedVideoListResponse struct {
+	Data       []TrashedVideoResponse `json:"data"`
+	Pagination PaginationMetadata     `json:"pagination"`
+}
+
+// CreateVideoImportRequest - Bulk import from exactly one source: a channel, a playlist or a list of URLs/IDs
+type CreateVideoImportRequest struct {
+	ChannelID  string   `json:"channel_id,omitempty" binding:"omitempty,max=100" example:"UC_x5XG1OV2P6uZZ5FSM9Ttw"`
+	PlaylistID string   `json:"playlist_id,omitempty" binding:"omitempty,max=100" example:"PLBCF2DAC6FFB574DE"`
+	URLs       []string `json:"urls,omitempty" binding:"omitempty,max=500,dive,min=1,max=500"` // Watch/youtu.be URLs or bare IDs
+	MaxVideos  int      `json:"max_videos,omitempty" binding:"omitempty,min=1,max=5000"`       // Stop after this many IDs (default: all)
+}
+
+// VideoImportJobResponse - Progress of a bulk import job
+type VideoImportJobResponse struct {
+	ID          string     `json:"id"`
+	Source      string     `json:"source"`              // channel | playlist | urls
+	SourceID    string     `json:"source_id,omitempty"` // Channel or playlist ID
+	Status      string     `json:"status"`              // queued | running | completed | failed
+	Total       int        `json:"total"`               // Expected number of videos (0 until known)
+	Found       int        `json:"found"`               // IDs read from the source so far
+	Processed   int        `json:"processed"`           // created + skipped + failed
+	Created     int        `json:"created"`
+	Skipped     int        `json:"skipped"` // Already in the database
+	Failed      int        `json:"failed"`
+	Errors      []string   `json:"errors"`          // Per-video errors (first 100)
+	Error       string     `json:"error,omitempty"` // Fatal error that stopped the job
+	RequestedBy string     `json:"requested_by"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// VideoImportJobListResponse - Paginated import jobs, newest first
+type VideoImportJobListResponse struct {
+	Data       []VideoImportJobResponse `json:"data"`
+	Pagination PaginationMetadata       `json:"pagination"`
+}
@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================
+// Alias Management Handlers
+// ============================================================
+
+// respondAliasError maps alias management errors to API responses
+func respondAliasError(c *gin.Context, operation, aliasID string, err error) {
+	statusCode := http.StatusInternalServerError
+	var apiResponse dto.APIResponse
+
+	switch {
+	case errors.Is(err, domain.ErrInvalidRequest):
+		statusCode = http.StatusBadRequest
+		apiResponse = dto.NewValidationErrorResponse("request", err.Error())
+	case errors.Is(err, domain.ErrAliasNotFound):
+		statusCode = http.StatusNotFound
+		apiResponse = dto.NewNotFoundResponse("tag alias", aliasID)
+	case errors.Is(err, domain.ErrCanonicalTagNotFound), errors.Is(err, domain.ErrTargetTagNotFound):
+		statusCode = http.StatusNotFound
+		apiResponse = dto.NewNotFoundResponse("canonical tag", c.Param("id"))
+	case errors.Is(err, domain.ErrSameSourceTarget):
+		statusCode = http.StatusBadRequest
+		apiResponse = dto.NewValidationErrorResponse("target_id", "Alias already belongs to this canonical tag")
+	case errors.Is(err, domain.ErrLastAlias):
+		statusCode = http.StatusConflict
+		apiResponse = dto.NewConflictResponse("LAST_ALIAS", "Cannot detach the last alias of a canonical tag; merge or delete the tag instead", nil)
+	case errors.Is(err, domain.ErrSlugTaken):
+		statusCode = http.StatusConflict
+		apiResponse = dto.NewConflictResponse("SLUG_TAKEN", err.Error(), nil)
+	default:
+		apiResponse = dto.NewInternalErrorResponse(fmt.Sprintf("Failed to %s: %s", operation, err.Error()))
+	}
+
+	slog.Error("Alias operation failed",
+		"operation", operation,
+		"alias_id", aliasID,
+		"status_code", statusCode,
+		"error", err.Error(),
+	)
+	c.JSON(statusCode, apiResponse)
+}
+
+// ListTagAliases godoc
+// @Summary List aliases of a canonical tag
+// @Description List all aliases of a canonical tag with similarity scores and review status
+// @Tags Tags
+// @Produce json
+// @Param id path string true "Canonical Tag ID (UUID)"
+// @Success 200 {array} dto.TagAliasResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Router /v2/mod/tags/{id}/aliases [get]
+func (h *TagHandler) ListTagAliases(c *gin.Context) {
+	id := c.Param("id")
+
+	aliases, err := h.serviceV2.ListTagAliases(c.Request.Context(), id)
+	if err != nil {
+		respondAliasError(c, "list aliases", "", err)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(aliases, fmt.Sprintf("Tag has %d aliases", len(aliases)), nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// MoveAlias godoc
+// @Summary Move alias to another canonical tag
+// @Description Re-point an alias to a different canonical tag (e.g. fix a wrong auto-merge). Marks the alias as reviewed.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param alias_id path string true "Alias ID (UUID)"
+// @Param request body dto.MoveAliasRequest true "Target canonical tag"
+// @Success 200 {object} dto.TagAliasResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 409 {object} dto.APIResponse "Alias is the last one of its canonical tag"
+// @Router /v2/mod/tags/aliases/{alias_id}/move [post]
+func (h *TagHandler) MoveAlias(c *gin.Context) {
+	aliasID := c.Param("alias_id")
+
+	var req dto.MoveAliasRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("target_id", err.Error()))
+		return
+	}
+
+	alias, err := h.serviceV2.MoveAlias(c.Request.Context(), aliasID, req)
+	if err != nil {
+		respondAliasError(c, "move alias", aliasID, err)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(alias, fmt.Sprintf("Alias '%s' moved successfully", alias.RawText), nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// SplitAlias godoc
+// @Summary Split alias into its own canonical tag
+// @Description Detach an alias and create a new canonical tag for it
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param alias_id path string true "Alias ID (UUID)"
+// @Param request body dto.SplitAliasRequest false "Optional display name for the new canonical tag"
+// @Success 201 {object} dto.TagResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 409 {object} dto.APIResponse "Last alias or slug already taken"
+// @Router /v2/mod/tags/aliases/{alias_id}/split [post]
+func (h *TagHandler) SplitAlias(c *gin.Context) {
+	aliasID := c.Param("alias_id")
+
+	var req dto.SplitAliasRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("display_name", err.Error()))
+			return
+		}
+	}
+
+	tag, err := h.serviceV2.SplitAlias(c.Request.Context(), aliasID, req)
+	if err != nil {
+		respondAliasError(c, "split alias", aliasID, err)
+		return
+	}
+
+	apiResponse := dto.NewCreatedResponse(tag, fmt.Sprintf("Alias split into new tag '%s'", tag.Name), nil)
+	c.JSON(http.StatusCreated, apiResponse)
+}
+
+// DeleteAlias godoc
+// @Summary Delete alias
+// @Description Delete an alias. Refused if it is the last alias of its canonical tag.
+// @Tags Tags
+// @Produce json
+// @Param alias_id path string true "Alias ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 409 {object} dto.APIResponse "Alias is the last one of its canonical tag"
+// @Router /v2/mod/tags/aliases/{alias_id} [delete]
+func (h *TagHandler) DeleteAlias(c *gin.Context) {
+	aliasID := c.Param("alias_id")
+
+	if err := h.serviceV2.DeleteAlias(c.Request.Context(), aliasID); err != nil {
+		respondAliasError(c, "delete alias", aliasID, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// UpdateAliasReview godoc
+// @Summary Update alias review status
+// @Description Mark an alias as reviewed (recorded as an accept by the current moderator) or put it back in the review queue
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param alias_id path string true "Alias ID (UUID)"
+// @Param request body dto.UpdateAliasReviewRequest true "Review status"
+// @Success 200 {object} dto.TagAliasResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Router /v2/mod/tags/aliases/{alias_id}/review [patch]
+func (h *TagHandler) UpdateAliasReview(c *gin.Context) {
+	aliasID := c.Param("alias_id")
+	reviewerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateAliasReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("request", err.Error()))
+		return
+	}
+
+	alias, err := h.serviceV2.UpdateAliasReview(c.Request.Context(), aliasID, reviewerID, req.IsReviewed)
+	if err != nil {
+		respondAliasError(c, "update alias review", aliasID, err)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(alias, fmt.Sprintf("Alias '%s' review status updated to %v", alias.RawText, req.IsReviewed), nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
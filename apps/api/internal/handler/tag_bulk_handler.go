@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"api/internal/dto"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================
+// Bulk Video Tagging Handlers
+// ============================================================
+
+// BulkTagVideos godoc
+// @Summary Bulk tag videos
+// @Description Apply a list of tags (names or canonical tag IDs) to a list of videos.
+// @Description Each distinct name is resolved once (embeddings batched in one OpenAI call).
+// @Description Failures are reported per item; the request only fails on database errors.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkVideoTagRequest true "Videos and tags"
+// @Success 200 {object} dto.BulkVideoTagResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /v2/mod/videos/tags/bulk [post]
+func (h *TagHandler) BulkTagVideos(c *gin.Context) {
+	var req dto.BulkVideoTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("request", err.Error()))
+		return
+	}
+
+	result, err := h.serviceV2.BulkTagVideos(c.Request.Context(), req)
+	if err != nil {
+		slog.Error("BulkTagVideos failed",
+			"video_count", len(req.VideoIDs),
+			"tag_count", len(req.Tags),
+			"error", err.Error(),
+		)
+		c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to bulk tag videos: "+err.Error()))
+		return
+	}
+
+	message := fmt.Sprintf("%d added, %d already tagged, %d failed", result.AddedCount, result.SkippedCount, result.FailedCount)
+	c.JSON(http.StatusOK, dto.NewSuccessResponse(result, message, nil))
+}
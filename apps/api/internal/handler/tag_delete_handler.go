@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// currentUserIsAdmin reports whether the authenticated user (set by AuthMiddleware) is an admin
+func currentUserIsAdmin(c *gin.Context) bool {
+	role, exists := c.Get("role")
+	if !exists {
+		return false
+	}
+	userRole, ok := role.(domain.UserRole)
+	return ok && userRole == domain.UserRoleAdmin
+}
+
+// ============================================================
+// Tag Delete Handlers
+// ============================================================
+
+// DeleteCanonicalTag godoc
+// @Summary Delete canonical tag
+// @Description Delete a canonical tag. Without reassign_to the tag is unlinked from its videos and its aliases are deleted.
+// @Description With reassign_to the tag is merged into that tag (videos and aliases move, undoable via /merges/{id}/undo).
+// @Description Approved tags can only be deleted by admins.
+// @Tags Tags
+// @Produce json
+// @Param id path string true "Canonical Tag ID (UUID)"
+// @Param reassign_to query string false "Tag ID (UUID) that receives the videos and aliases"
+// @Success 200 {object} dto.DeleteTagResponse
+// @Failure 400 {object} dto.APIResponse "Invalid request"
+// @Failure 403 {object} dto.APIResponse "Approved tag, admin required"
+// @Failure 404 {object} dto.APIResponse "Tag not found"
+// @Router /v2/mod/tags/{id} [delete]
+func (h *TagHandler) DeleteCanonicalTag(c *gin.Context) {
+	id := c.Param("id")
+	reassignTo := c.Query("reassign_to")
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.serviceV2.DeleteTag(c.Request.Context(), id, reassignTo, userID, currentUserIsAdmin(c))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		var apiResponse dto.APIResponse
+
+		switch {
+		case errors.Is(err, domain.ErrInvalidRequest):
+			statusCode = http.StatusBadRequest
+			apiResponse = dto.NewValidationErrorResponse("request", err.Error())
+		case errors.Is(err, domain.ErrSameSourceTarget):
+			statusCode = http.StatusBadRequest
+			apiResponse = dto.NewValidationErrorResponse("reassign_to", "Cannot reassign a tag to itself")
+		case errors.Is(err, domain.ErrAdminRequired):
+			statusCode = http.StatusForbidden
+			apiResponse = dto.NewErrorResponse(dto.StatusError, "ADMIN_REQUIRED", "Approved tags can only be deleted by an admin", nil)
+		case errors.Is(err, domain.ErrTargetTagNotFound):
+			statusCode = http.StatusNotFound
+			apiResponse = dto.NewNotFoundResponse("reassign target tag", reassignTo)
+		case errors.Is(err, domain.ErrCanonicalTagNotFound), errors.Is(err, domain.ErrSourceTagNotFound):
+			statusCode = http.StatusNotFound
+			apiResponse = dto.NewNotFoundResponse("canonical tag", id)
+		default:
+			apiResponse = dto.NewInternalErrorResponse("Failed to delete tag: " + err.Error())
+		}
+
+		slog.Error("DeleteCanonicalTag failed",
+			"tag_id", id,
+			"reassign_to", reassignTo,
+			"status_code", statusCode,
+			"error", err.Error(),
+		)
+		c.JSON(statusCode, apiResponse)
+		return
+	}
+
+	message := fmt.Sprintf("Tag deleted: %d videos unlinked, %d aliases removed", result.AffectedVideoCount, result.AffectedAliasCount)
+	if result.ReassignedTo != nil {
+		message = fmt.Sprintf("Tag deleted: %d videos and %d aliases reassigned to '%s'",
+			result.AffectedVideoCount, result.AffectedAliasCount, result.ReassignedTo.Name)
+	}
+	apiResponse := dto.NewSuccessResponse(result, message, nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================
+// Localized Display Names Handlers
+// ============================================================
+
+// PromoteAliasDisplayName godoc
+// @Summary Promote alias to localized display name
+// @Description Use an existing alias of the tag as its display name for a locale (default: the alias language).
+// @Description Public endpoints pick the name from the lang query parameter or Accept-Language, falling back to the default name.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path string true "Canonical Tag ID (UUID)"
+// @Param request body dto.PromoteAliasDisplayNameRequest true "Alias and optional locale"
+// @Success 200 {object} dto.TagResponse
+// @Failure 400 {object} dto.APIResponse "Invalid request, alias of another tag or unknown alias language"
+// @Failure 404 {object} dto.APIResponse "Tag or alias not found"
+// @Router /v2/mod/tags/{id}/display-names [put]
+func (h *TagHandler) PromoteAliasDisplayName(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.PromoteAliasDisplayNameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("alias_id", err.Error()))
+		return
+	}
+
+	tag, err := h.serviceV2.PromoteAliasDisplayName(c.Request.Context(), id, req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		var apiResponse dto.APIResponse
+
+		switch {
+		case errors.Is(err, domain.ErrInvalidRequest):
+			statusCode = http.StatusBadRequest
+			apiResponse = dto.NewValidationErrorResponse("request", err.Error())
+		case errors.Is(err, domain.ErrCanonicalTagNotFound):
+			statusCode = http.StatusNotFound
+			apiResponse = dto.NewNotFoundResponse("canonical tag", id)
+		case errors.Is(err, domain.ErrAliasNotFound):
+			statusCode = http.StatusNotFound
+			apiResponse = dto.NewNotFoundResponse("alias", req.AliasID)
+		default:
+			apiResponse = dto.NewInternalErrorResponse("Failed to set display name: " + err.Error())
+		}
+
+		slog.Error("PromoteAliasDisplayName failed",
+			"tag_id", id,
+			"alias_id", req.AliasID,
+			"locale", req.Locale,
+			"status_code", statusCode,
+			"error", err.Error(),
+		)
+		c.JSON(statusCode, apiResponse)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(tag, "Display name updated", nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// DeleteTagDisplayName godoc
+// @Summary Remove localized display name
+// @Description Remove the display name of a locale; that locale falls back to the default name
+// @Tags Tags
+// @Produce json
+// @Param id path string true "Canonical Tag ID (UUID)"
+// @Param locale path string true "Locale (e.g. vi, en)"
+// @Success 200 {object} dto.TagResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse "Tag or display name not found"
+// @Router /v2/mod/tags/{id}/display-names/{locale} [delete]
+func (h *TagHandler) DeleteTagDisplayName(c *gin.Context) {
+	id := c.Param("id")
+	locale := c.Param("locale")
+
+	tag, err := h.serviceV2.DeleteTagDisplayName(c.Request.Context(), id, locale)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidRequest):
+			c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("request", err.Error()))
+		case errors.Is(err, domain.ErrCanonicalTagNotFound):
+			c.JSON(http.StatusNotFound, dto.NewNotFoundResponse("canonical tag", id))
+		case errors.Is(err, domain.ErrDisplayNameNotFound):
+			c.JSON(http.StatusNotFound, dto.NewNotFoundResponse("display name", locale))
+		default:
+			slog.Error("DeleteTagDisplayName failed", "tag_id", id, "locale", locale, "error", err.Error())
+			c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to delete display name: "+err.Error()))
+		}
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(tag, fmt.Sprintf("Display name for '%s' removed", locale), nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
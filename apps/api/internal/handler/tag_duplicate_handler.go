@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================
+// Duplicate Detection Handlers
+// ============================================================
+
+// respondDuplicateError maps duplicate candidate errors to API responses
+func respondDuplicateError(c *gin.Context, operation, candidateID string, err error) {
+	statusCode := http.StatusInternalServerError
+	var apiResponse dto.APIResponse
+
+	switch {
+	case errors.Is(err, domain.ErrInvalidRequest):
+		statusCode = http.StatusBadRequest
+		apiResponse = dto.NewValidationErrorResponse("request", err.Error())
+	case errors.Is(err, domain.ErrDuplicateNotFound):
+		statusCode = http.StatusNotFound
+		apiResponse = dto.NewNotFoundResponse("duplicate candidate", candidateID)
+	case errors.Is(err, domain.ErrDuplicateResolved):
+		statusCode = http.StatusConflict
+		apiResponse = dto.NewConflictResponse("DUPLICATE_RESOLVED", "Duplicate candidate was already accepted or dismissed", nil)
+	case errors.Is(err, domain.ErrSourceTagNotFound), errors.Is(err, domain.ErrTargetTagNotFound):
+		statusCode = http.StatusNotFound
+		apiResponse = dto.NewNotFoundResponse("canonical tag", err.Error())
+	default:
+		apiResponse = dto.NewInternalErrorResponse(fmt.Sprintf("Failed to %s: %s", operation, err.Error()))
+	}
+
+	slog.Error("Duplicate candidate operation failed",
+		"operation", operation,
+		"candidate_id", candidateID,
+		"status_code", statusCode,
+		"error", err.Error(),
+	)
+	c.JSON(statusCode, apiResponse)
+}
+
+// ListDuplicateTags godoc
+// @Summary List duplicate tag suggestions
+// @Description Pending pairs of canonical tags that are likely duplicates, highest score first.
+// @Description Evidence includes the closest alias pair, slug/trigram similarity and co-occurring videos.
+// @Tags Tags
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} dto.TagDuplicateListResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /v2/mod/tags/duplicates [get]
+func (h *TagHandler) ListDuplicateTags(c *gin.Context) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := parsePositiveInt(p); err == nil {
+			page = parsed
+		}
+	}
+
+	limit := 20
+	if l := c.Query("limit"); l != "" {
+		if parsed, err := parsePositiveInt(l); err == nil {
+			limit = min(parsed, MaxTagSearchLimit)
+		}
+	}
+
+	result, err := h.serviceV2.ListDuplicateCandidates(c.Request.Context(), page, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to list duplicate tags: "+err.Error()))
+		return
+	}
+
+	metadata := &dto.Metadata{
+		Pagination: &result.Pagination,
+	}
+	apiResponse := dto.NewSuccessResponse(result.Data, fmt.Sprintf("%d duplicate suggestions", result.Pagination.TotalItems), metadata)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// ScanDuplicateTags godoc
+// @Summary Run duplicate detection now
+// @Description Run the duplicate detection job immediately instead of waiting for the next scheduled run
+// @Tags Tags
+// @Produce json
+// @Success 200 {object} dto.DuplicateScanResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /v2/mod/tags/duplicates/scan [post]
+func (h *TagHandler) ScanDuplicateTags(c *gin.Context) {
+	pending, err := h.serviceV2.DetectDuplicateTags(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to scan duplicate tags: "+err.Error()))
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(dto.DuplicateScanResponse{PendingCount: pending}, "Duplicate scan completed", nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// AcceptDuplicateTag godoc
+// @Summary Accept duplicate suggestion
+// @Description Merge the pair using MergeTags. target_id selects the tag to keep; defaults to the approved (or older) tag.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path int true "Duplicate candidate ID"
+// @Param request body dto.AcceptDuplicateRequest false "Tag to keep"
+// @Success 200 {object} dto.MergeTagsResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 409 {object} dto.APIResponse
+// @Router /v2/mod/tags/duplicates/{id}/accept [post]
+func (h *TagHandler) AcceptDuplicateTag(c *gin.Context) {
+	candidateID := c.Param("id")
+	reviewerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.AcceptDuplicateRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("target_id", err.Error()))
+			return
+		}
+	}
+
+	result, err := h.serviceV2.AcceptDuplicate(c.Request.Context(), candidateID, reviewerID, req)
+	if err != nil {
+		respondDuplicateError(c, "accept duplicate", candidateID, err)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(result, fmt.Sprintf("Tags merged into '%s'", result.TargetTag.Name), nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// DismissDuplicateTag godoc
+// @Summary Dismiss duplicate suggestion
+// @Description Mark the pair as not a duplicate; it will not be suggested again
+// @Tags Tags
+// @Produce json
+// @Param id path int true "Duplicate candidate ID"
+// @Success 200 {object} dto.APIResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 409 {object} dto.APIResponse
+// @Router /v2/mod/tags/duplicates/{id}/dismiss [post]
+func (h *TagHandler) DismissDuplicateTag(c *gin.Context) {
+	candidateID := c.Param("id")
+	reviewerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.serviceV2.DismissDuplicate(c.Request.Context(), candidateID, reviewerID); err != nil {
+		respondDuplicateError(c, "dismiss duplicate", candidateID, err)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(nil, "Duplicate suggestion dismissed", nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
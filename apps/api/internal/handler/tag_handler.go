@@ -183,7 +183,11 @@ func (h *TagHandler) GetVideoCanonicalTags(c *gin.Context) {
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
 // @Param query query string false "Search query"
+// @Param tree query bool false "Return the topic tree with per-node video counts (ignores pagination)"
+// @Param sort query string false "Sort order" Enums(name, popular, growing) default(name)
+// @Param days query int false "Growth window in days for sort=growing" default(7)
 // @Success 200 {object} dto.TagListResponse
+// @Success 200 {array} dto.TagTreeNodeResponse "When tree=true"
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /v2/mod/tags [get]
 func (h *TagHandler) ListCanonicalTags(c *gin.Context) {
@@ -194,6 +198,19 @@ func (h *TagHandler) ListCanonicalTags(c *gin.Context) {
 		return
 	}
 
+	if req.Tree {
+		tree, err := h.serviceV2.GetTagTree(c.Request.Context(), req.ApprovedOnly)
+		if err != nil {
+			apiResponse := dto.NewInternalErrorResponse("Failed to load tag tree: " + err.Error())
+			c.JSON(http.StatusInternalServerError, apiResponse)
+			return
+		}
+
+		apiResponse := dto.NewSuccessResponse(tree, "Tag tree retrieved successfully", nil)
+		c.JSON(http.StatusOK, apiResponse)
+		return
+	}
+
 	// Validate and normalize limit parameter
 	if req.Limit < 1 {
 		req.Limit = 20 // default
@@ -218,6 +235,36 @@ func (h *TagHandler) ListCanonicalTags(c *gin.Context) {
 	c.JSON(http.StatusOK, apiResponse)
 }
 
+// GetTrendingTags godoc
+// @Summary Get trending tags
+// @Description Tags with the most videos tagged within the recent window
+// @Tags Tags
+// @Produce json
+// @Param days query int false "Window in days" default(7)
+// @Param limit query int false "Max tags" default(20)
+// @Param approved_only query bool false "Only approved tags"
+// @Param lang query string false "Name locale (e.g. vi, en); overrides Accept-Language"
+// @Success 200 {array} dto.TrendingTagResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /tags/trending [get]
+func (h *TagHandler) GetTrendingTags(c *gin.Context) {
+	var req dto.TrendingTagsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("query", err.Error()))
+		return
+	}
+
+	trending, err := h.serviceV2.GetTrendingTags(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to get trending tags: "+err.Error()))
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(trending, "Trending tags retrieved successfully", nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
 // GetCanonicalTag godoc
 // @Summary Get canonical tag by ID
 // @Description Get canonical tag details by tag ID
@@ -245,7 +292,8 @@ func (h *TagHandler) GetCanonicalTag(c *gin.Context) {
 // CreateCanonicalTag godoc
 // @Summary Create a new canonical tag (v2 - with auto-resolution)
 // @Description Create a canonical tag using 4-layer resolution. Automatically merges similar tags.
-// @Description Returns existing tag if semantically similar (>85% similarity).
+// @Description Returns existing tag if semantically similar (threshold and merge mode come from the resolution policy).
+// @Description Concurrent requests for the same new name are serialized: one creates the tag, the others get it.
 // @Tags Tags
 // @Accept json
 // @Produce json
@@ -253,6 +301,8 @@ func (h *TagHandler) GetCanonicalTag(c *gin.Context) {
 // @Success 201 {object} dto.CanonicalTagResponse "Tag created"
 // @Success 200 {object} dto.CanonicalTagResponse "Existing similar tag returned (auto-merged)"
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.APIResponse "No free slug, or the concurrently created tag was removed (retry)"
+// @Failure 503 {object} dto.APIResponse "OpenAI unavailable and the resolution policy forbids creating tags without embedding"
 // @Router /v2/mod/tags [post]
 func (h *TagHandler) CreateCanonicalTag(c *gin.Context) {
 	startTime := time.Now()
@@ -273,8 +323,25 @@ func (h *TagHandler) CreateCanonicalTag(c *gin.Context) {
 	canonicalTag, matchedAlias, isNewTag, err := h.serviceV2.ResolveTag(c.Request.Context(), req.Name)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
+		var apiResponse dto.APIResponse
+
+		switch {
+		case errors.Is(err, domain.ErrAliasTaken):
+			// Lost a race and the winner vanished before we could read it - safe to retry
+			statusCode = http.StatusConflict
+			apiResponse = dto.NewConflictResponse("ALIAS_TAKEN", err.Error(), nil)
+		case errors.Is(err, domain.ErrSlugTaken):
+			statusCode = http.StatusConflict
+			apiResponse = dto.NewConflictResponse("SLUG_TAKEN", err.Error(), nil)
+		case errors.Is(err, domain.ErrEmbeddingUnavailable):
+			// Resolution policy forbids creating tags without semantic check
+			statusCode = http.StatusServiceUnavailable
+			apiResponse = dto.NewErrorResponse(dto.StatusError, "EMBEDDING_UNAVAILABLE", err.Error(), nil)
+		default:
+			apiResponse = dto.NewInternalErrorResponse("Failed to create canonical tag: " + err.Error())
+		}
+
 		fmt.Printf("[ERROR] CreateCanonicalTag failed: %v (status: %d)\n", err, statusCode)
-		apiResponse := dto.NewInternalErrorResponse("Failed to create canonical tag: " + err.Error())
 		c.JSON(statusCode, apiResponse)
 		return
 	}
@@ -332,6 +399,68 @@ func (h *TagHandler) CreateCanonicalTag(c *gin.Context) {
 	c.JSON(statusCode, apiResponse)
 }
 
+// ResolveTag godoc
+// @Summary Resolve a tag name (optionally dry-run)
+// @Description With dry_run=true, explains each resolution layer (exact, translation, embedding, vector threshold)
+// @Description and the decision that would be taken, without creating aliases or canonicals.
+// @Description Without dry_run, behaves like POST /v2/mod/tags.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param dry_run query bool false "Only explain the decision, write nothing" default(false)
+// @Param top_k query int false "Number of nearest aliases to return" default(5)
+// @Param tag body dto.CreateTagRequest true "Tag name to resolve"
+// @Success 200 {object} dto.TagResolveTraceResponse "Resolution trace (dry-run)"
+// @Failure 400 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /v2/mod/tags/resolve [post]
+func (h *TagHandler) ResolveTag(c *gin.Context) {
+	if c.Query("dry_run") != "true" {
+		h.CreateCanonicalTag(c)
+		return
+	}
+
+	startTime := time.Now()
+
+	topK := 5 // default
+	if topKStr := c.Query("top_k"); topKStr != "" {
+		parsed, err := strconv.Atoi(topKStr)
+		if err != nil || parsed < 1 {
+			apiResponse := dto.NewValidationErrorResponse("top_k", "top_k must be a positive integer")
+			c.JSON(http.StatusBadRequest, apiResponse)
+			return
+		}
+		topK = min(parsed, MaxTagSearchLimit)
+	}
+
+	var req dto.CreateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apiResponse := dto.NewValidationErrorResponse("name", err.Error())
+		c.JSON(http.StatusBadRequest, apiResponse)
+		return
+	}
+
+	trace, err := h.serviceV2.ExplainResolveTag(c.Request.Context(), req.Name, topK)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRequest) {
+			c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("name", err.Error()))
+			return
+		}
+		slog.Error("ExplainResolveTag failed", "input", req.Name, "error", err.Error())
+		c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to explain tag resolution: "+err.Error()))
+		return
+	}
+
+	processingTime := time.Since(startTime).Milliseconds()
+	metadata := dto.NewTagMetadata(dto.TagMetadataOptions{
+		OriginalInput:    &req.Name,
+		ProcessingTimeMs: &processingTime,
+	})
+
+	apiResponse := dto.NewSuccessResponse(trace, fmt.Sprintf("Dry-run decision: %s", trace.Decision), metadata)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
 // SearchCanonicalTags godoc
 // @Summary Search canonical tags
 // @Description Search canonical tags by name (hybrid: SQL LIKE + Vector similarity)
@@ -415,10 +544,15 @@ func (h *TagHandler) MergeTags(c *gin.Context) {
 		return
 	}
 
+	mergedBy, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
 	fmt.Printf("[MERGE_TAGS] Request: source=%s, target=%s\n", req.SourceID, req.TargetID)
 
 	// Call service to perform merge
-	result, err := h.serviceV2.MergeTags(c.Request.Context(), req)
+	result, err := h.serviceV2.MergeTags(c.Request.Context(), req, mergedBy)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		var apiResponse dto.APIResponse
@@ -467,6 +601,127 @@ func (h *TagHandler) MergeTags(c *gin.Context) {
 	c.JSON(http.StatusOK, apiResponse)
 }
 
+// UndoMerge godoc
+// @Summary Undo a tag merge
+// @Description Restore the source tag of a merge with its original ID, aliases and video relations.
+// @Description Refused with 409 if the merged tags changed since (aliases moved/deleted, video links removed, slug reused).
+// @Tags Tags
+// @Produce json
+// @Param id path int true "Merge ID (merge_id from the merge response)"
+// @Success 200 {object} dto.UndoMergeResponse
+// @Failure 400 {object} dto.APIResponse "Invalid merge ID"
+// @Failure 404 {object} dto.APIResponse "Merge not found"
+// @Failure 409 {object} dto.APIResponse "Already undone or tags changed since merge"
+// @Failure 500 {object} dto.APIResponse "Internal error"
+// @Router /v2/mod/tags/merges/{id}/undo [post]
+func (h *TagHandler) UndoMerge(c *gin.Context) {
+	mergeID := c.Param("id")
+	undoneBy, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.serviceV2.UndoMerge(c.Request.Context(), mergeID, undoneBy)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		var apiResponse dto.APIResponse
+
+		switch {
+		case errors.Is(err, domain.ErrInvalidRequest):
+			statusCode = http.StatusBadRequest
+			apiResponse = dto.NewValidationErrorResponse("id", err.Error())
+		case errors.Is(err, domain.ErrMergeLogNotFound):
+			statusCode = http.StatusNotFound
+			apiResponse = dto.NewNotFoundResponse("merge", mergeID)
+		case errors.Is(err, domain.ErrMergeAlreadyUndone):
+			statusCode = http.StatusConflict
+			apiResponse = dto.NewConflictResponse("MERGE_ALREADY_UNDONE", err.Error(), nil)
+		case errors.Is(err, domain.ErrMergeUndoConflict):
+			statusCode = http.StatusConflict
+			apiResponse = dto.NewConflictResponse("MERGE_CHANGED", err.Error(), nil)
+		default:
+			apiResponse = dto.NewInternalErrorResponse("Failed to undo merge: " + err.Error())
+		}
+
+		slog.Error("UndoMerge failed",
+			"merge_id", mergeID,
+			"status_code", statusCode,
+			"error", err.Error(),
+		)
+		c.JSON(statusCode, apiResponse)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(
+		result,
+		fmt.Sprintf("Restored '%s' from '%s'", result.RestoredTag.Name, result.TargetTag.Name),
+		nil,
+	)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// ============================================================
+// Tag Hierarchy Operations
+// ============================================================
+
+// SetTagParent godoc
+// @Summary Move tag in the topic tree
+// @Description Move a tag together with its whole subtree under another tag. parent_id = null moves it to the root.
+// @Description Refused if the new parent is the tag itself or one of its descendants.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path string true "Canonical Tag ID (UUID)"
+// @Param request body dto.SetTagParentRequest true "New parent"
+// @Success 200 {object} dto.TagResponse
+// @Failure 400 {object} dto.APIResponse "Invalid request"
+// @Failure 404 {object} dto.APIResponse "Tag or parent not found"
+// @Failure 409 {object} dto.APIResponse "Move would create a cycle"
+// @Router /v2/mod/tags/{id}/parent [patch]
+func (h *TagHandler) SetTagParent(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.SetTagParentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("parent_id", err.Error()))
+		return
+	}
+
+	tag, err := h.serviceV2.SetTagParent(c.Request.Context(), id, req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		var apiResponse dto.APIResponse
+
+		switch {
+		case errors.Is(err, domain.ErrInvalidRequest):
+			statusCode = http.StatusBadRequest
+			apiResponse = dto.NewValidationErrorResponse("request", err.Error())
+		case errors.Is(err, domain.ErrCanonicalTagNotFound):
+			statusCode = http.StatusNotFound
+			apiResponse = dto.NewNotFoundResponse("canonical tag", id)
+		case errors.Is(err, domain.ErrParentTagNotFound):
+			statusCode = http.StatusNotFound
+			apiResponse = dto.NewNotFoundResponse("parent tag", *req.ParentID)
+		case errors.Is(err, domain.ErrTagCycle):
+			statusCode = http.StatusConflict
+			apiResponse = dto.NewConflictResponse("TAG_CYCLE", err.Error(), nil)
+		default:
+			apiResponse = dto.NewInternalErrorResponse("Failed to move tag: " + err.Error())
+		}
+
+		slog.Error("SetTagParent failed",
+			"tag_id", id,
+			"status_code", statusCode,
+			"error", err.Error(),
+		)
+		c.JSON(statusCode, apiResponse)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(tag, "Tag moved successfully", nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
 // ============================================================
 // Tag Approval Operations
 // ============================================================
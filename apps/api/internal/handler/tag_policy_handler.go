@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================
+// Resolution Policy Handlers (admin)
+// ============================================================
+
+// GetResolutionPolicy godoc
+// @Summary Get tag resolution policy
+// @Description Runtime settings of the tag resolver: auto-merge distance (global and per language), match strategy (alias_min, centroid, hybrid), merge mode,
+// @Description translation layer, alias auto-approval and behaviour when OpenAI is unavailable.
+// @Tags Tags
+// @Produce json
+// @Success 200 {object} dto.ResolutionPolicyResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /v2/admin/tags/resolution-policy [get]
+func (h *TagHandler) GetResolutionPolicy(c *gin.Context) {
+	policy, err := h.serviceV2.GetResolutionPolicy(c.Request.Context())
+	if err != nil {
+		slog.Error("GetResolutionPolicy failed", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to get resolution policy: "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewSuccessResponse(policy, "Resolution policy retrieved", nil))
+}
+
+// UpdateResolutionPolicy godoc
+// @Summary Update tag resolution policy
+// @Description Change any subset of the settings. Takes effect without restart; every change is recorded with its author and reason.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param request body dto.UpdateResolutionPolicyRequest true "Settings to change"
+// @Success 200 {object} dto.ResolutionPolicyResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /v2/admin/tags/resolution-policy [patch]
+func (h *TagHandler) UpdateResolutionPolicy(c *gin.Context) {
+	adminID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateResolutionPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("request", err.Error()))
+		return
+	}
+
+	policy, err := h.serviceV2.UpdateResolutionPolicy(c.Request.Context(), req, adminID)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRequest) {
+			c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("policy", err.Error()))
+			return
+		}
+		slog.Error("UpdateResolutionPolicy failed", "admin_id", adminID, "error", err.Error())
+		c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to update resolution policy: "+err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewSuccessResponse(policy, "Resolution policy updated", nil))
+}
+
+// ListResolutionPolicyChanges godoc
+// @Summary Resolution policy audit trail
+// @Description Who changed the resolution policy, when, why, and the settings before/after. Newest first.
+// @Tags Tags
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} dto.ResolutionPolicyChangeListResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /v2/admin/tags/resolution-policy/changes [get]
+func (h *TagHandler) ListResolutionPolicyChanges(c *gin.Context) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := parsePositiveInt(p); err == nil {
+			page = parsed
+		}
+	}
+
+	limit := 20
+	if l := c.Query("limit"); l != "" {
+		if parsed, err := parsePositiveInt(l); err == nil {
+			limit = min(parsed, MaxTagSearchLimit)
+		}
+	}
+
+	result, err := h.serviceV2.ListResolutionPolicyChanges(c.Request.Context(), page, limit)
+	if err != nil {
+		slog.Error("ListResolutionPolicyChanges failed", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to list policy changes: "+err.Error()))
+		return
+	}
+
+	metadata := &dto.Metadata{
+		Pagination: &result.Pagination,
+	}
+	apiResponse := dto.NewSuccessResponse(result.Data, fmt.Sprintf("%d policy changes", result.Pagination.TotalItems), metadata)
+	c.JSON(http.StatusOK, apiResponse)
+}
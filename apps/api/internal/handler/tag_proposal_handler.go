@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ============================================================
+// User Tag Proposal Handlers
+// ============================================================
+
+// respondProposalError maps tag proposal errors to API responses
+func respondProposalError(c *gin.Context, operation, id string, err error) {
+	statusCode := http.StatusInternalServerError
+	var apiResponse dto.APIResponse
+
+	switch {
+	case errors.Is(err, domain.ErrInvalidRequest):
+		statusCode = http.StatusBadRequest
+		apiResponse = dto.NewValidationErrorResponse("request", err.Error())
+	case errors.Is(err, domain.ErrProposalNotFound):
+		statusCode = http.StatusNotFound
+		apiResponse = dto.NewNotFoundResponse("tag proposal", id)
+	case errors.Is(err, domain.ErrVideoNotFound):
+		statusCode = http.StatusNotFound
+		apiResponse = dto.NewNotFoundResponse("video", err.Error())
+	case errors.Is(err, domain.ErrCanonicalTagNotFound):
+		statusCode = http.StatusNotFound
+		apiResponse = dto.NewNotFoundResponse("canonical tag", err.Error())
+	case errors.Is(err, domain.ErrProposalReviewed):
+		statusCode = http.StatusConflict
+		apiResponse = dto.NewConflictResponse("PROPOSAL_REVIEWED", "Tag proposal was already approved or rejected", nil)
+	case errors.Is(err, domain.ErrProposalExists):
+		statusCode = http.StatusConflict
+		apiResponse = dto.NewConflictResponse("PROPOSAL_EXISTS", err.Error(), nil)
+	case errors.Is(err, domain.ErrVideoAlreadyTagged):
+		statusCode = http.StatusConflict
+		apiResponse = dto.NewConflictResponse("ALREADY_TAGGED", err.Error(), nil)
+	default:
+		apiResponse = dto.NewInternalErrorResponse(fmt.Sprintf("Failed to %s: %s", operation, err.Error()))
+	}
+
+	slog.Error("Tag proposal operation failed",
+		"operation", operation,
+		"id", id,
+		"status_code", statusCode,
+		"error", err.Error(),
+	)
+	c.JSON(statusCode, apiResponse)
+}
+
+// ProposeVideoTag godoc
+// @Summary Propose a tag for a video
+// @Description Any signed-in user can propose a tag. It is linked to the video only after a mod approves it.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path string true "Video ID (UUID)"
+// @Param request body dto.CreateTagProposalRequest true "Proposed tag"
+// @Success 201 {object} dto.TagProposalResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 409 {object} dto.APIResponse "Already proposed or already tagged"
+// @Router /v1/videos/{id}/tag-proposals [post]
+func (h *TagHandler) ProposeVideoTag(c *gin.Context) {
+	videoID := c.Param("id")
+	proposerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateTagProposalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("tag_name", err.Error()))
+		return
+	}
+
+	proposal, err := h.serviceV2.ProposeTag(c.Request.Context(), videoID, proposerID, req)
+	if err != nil {
+		respondProposalError(c, "propose tag", videoID, err)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(proposal, fmt.Sprintf("Tag '%s' proposed, waiting for review", proposal.TagName), nil)
+	c.JSON(http.StatusCreated, apiResponse)
+}
+
+// ListMyTagProposals godoc
+// @Summary My tag proposals
+// @Description Proposal history of the signed-in user, newest first, with review outcome and rejection reason
+// @Tags Tags
+// @Produce json
+// @Param status query string false "pending, approved or rejected (default: all)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} dto.TagProposalListResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /v1/auth/me/tag-proposals [get]
+func (h *TagHandler) ListMyTagProposals(c *gin.Context) {
+	proposerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	h.listTagProposals(c, c.Query("status"), &proposerID)
+}
+
+// ListTagProposals godoc
+// @Summary Tag proposal queue
+// @Description Proposals from regular users. Defaults to the pending queue, oldest first.
+// @Tags Tags
+// @Produce json
+// @Param status query string false "pending (default), approved, rejected or all"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} dto.TagProposalListResponse
+// @Failure 400 {object} dto.APIResponse
+// @Router /v2/mod/tags/proposals [get]
+func (h *TagHandler) ListTagProposals(c *gin.Context) {
+	status := c.DefaultQuery("status", string(domain.TagProposalPending))
+	if status == "all" {
+		status = ""
+	}
+	h.listTagProposals(c, status, nil)
+}
+
+// listTagProposals writes a paginated proposal list (shared by the user history and the mod queue)
+func (h *TagHandler) listTagProposals(c *gin.Context, status string, proposerID *uuid.UUID) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := parsePositiveInt(p); err == nil {
+			page = parsed
+		}
+	}
+
+	limit := 20
+	if l := c.Query("limit"); l != "" {
+		if parsed, err := parsePositiveInt(l); err == nil {
+			limit = min(parsed, MaxTagSearchLimit)
+		}
+	}
+
+	result, err := h.serviceV2.ListTagProposals(c.Request.Context(), status, proposerID, page, limit)
+	if err != nil {
+		respondProposalError(c, "list tag proposals", "", err)
+		return
+	}
+
+	metadata := &dto.Metadata{
+		Pagination: &result.Pagination,
+	}
+	apiResponse := dto.NewSuccessResponse(result.Data, fmt.Sprintf("%d tag proposals", result.Pagination.TotalItems), metadata)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// ApproveTagProposal godoc
+// @Summary Approve tag proposal
+// @Description Resolve the proposed name (or use tag_id) and link the tag to the video.
+// @Description approve_tag also marks the canonical tag approved.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path string true "Proposal ID (UUID)"
+// @Param request body dto.ApproveTagProposalRequest false "Overrides"
+// @Success 200 {object} dto.TagProposalResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 409 {object} dto.APIResponse
+// @Router /v2/mod/tags/proposals/{id}/approve [post]
+func (h *TagHandler) ApproveTagProposal(c *gin.Context) {
+	proposalID := c.Param("id")
+	reviewerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ApproveTagProposalRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("tag_id", err.Error()))
+			return
+		}
+	}
+
+	proposal, err := h.serviceV2.ApproveTagProposal(c.Request.Context(), proposalID, reviewerID, req)
+	if err != nil {
+		respondProposalError(c, "approve tag proposal", proposalID, err)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(proposal, fmt.Sprintf("Proposal '%s' approved", proposal.TagName), nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// RejectTagProposal godoc
+// @Summary Reject tag proposal
+// @Description Close the proposal without linking the tag. The reason is shown to the proposer.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path string true "Proposal ID (UUID)"
+// @Param request body dto.RejectTagProposalRequest true "Rejection reason"
+// @Success 200 {object} dto.TagProposalResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 409 {object} dto.APIResponse
+// @Router /v2/mod/tags/proposals/{id}/reject [post]
+func (h *TagHandler) RejectTagProposal(c *gin.Context) {
+	proposalID := c.Param("id")
+	reviewerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.RejectTagProposalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("reason", err.Error()))
+		return
+	}
+
+	proposal, err := h.serviceV2.RejectTagProposal(c.Request.Context(), proposalID, reviewerID, req)
+	if err != nil {
+		respondProposalError(c, "reject tag proposal", proposalID, err)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(proposal, fmt.Sprintf("Proposal '%s' rejected", proposal.TagName), nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================
+// Related Tags Handlers
+// ============================================================
+
+// GetRelatedTags godoc
+// @Summary Get related tags
+// @Description "See also" tags, scored by co-occurrence on videos (Jaccard) blended with alias-embedding proximity.
+// @Description Relations are refreshed periodically by a background job.
+// @Tags Tags
+// @Produce json
+// @Param id path string true "Canonical Tag ID (UUID)"
+// @Param limit query int false "Max related tags" default(20)
+// @Param approved_only query bool false "Only approved related tags"
+// @Param lang query string false "Name locale (e.g. vi, en); overrides Accept-Language"
+// @Success 200 {array} dto.RelatedTagResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Router /tags/{id}/related [get]
+func (h *TagHandler) GetRelatedTags(c *gin.Context) {
+	id := c.Param("id")
+	approvedOnly := c.Query("approved_only") == "true"
+
+	limit := 0 // Service default
+	if l := c.Query("limit"); l != "" {
+		if parsed, err := parsePositiveInt(l); err == nil {
+			limit = parsed
+		}
+	}
+
+	related, err := h.serviceV2.GetRelatedTags(c.Request.Context(), id, limit, approvedOnly)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidRequest):
+			c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("id", err.Error()))
+		case errors.Is(err, domain.ErrCanonicalTagNotFound):
+			c.JSON(http.StatusNotFound, dto.NewNotFoundResponse("canonical tag", id))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to get related tags: "+err.Error()))
+		}
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(related, fmt.Sprintf("%d related tags", len(related)), nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// RefreshRelatedTags godoc
+// @Summary Recompute related tags now
+// @Description Run the related tags job immediately instead of waiting for the next scheduled run
+// @Tags Tags
+// @Produce json
+// @Success 200 {object} dto.RelatedTagsRefreshResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /v2/mod/tags/related/refresh [post]
+func (h *TagHandler) RefreshRelatedTags(c *gin.Context) {
+	count, err := h.serviceV2.RefreshRelatedTags(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to refresh related tags: "+err.Error()))
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(dto.RelatedTagsRefreshResponse{RelationCount: count}, "Related tags refreshed", nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================
+// Rename & Slug Handlers
+// ============================================================
+
+// RenameTag godoc
+// @Summary Rename canonical tag
+// @Description Rename a canonical tag. The slug is regenerated (with a numeric suffix on collision),
+// @Description the old slug keeps resolving via /tags/by-slug and the old name is kept as an alias.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path string true "Canonical Tag ID (UUID)"
+// @Param request body dto.RenameTagRequest true "New display name"
+// @Success 200 {object} dto.RenameTagResponse
+// @Failure 400 {object} dto.APIResponse "Invalid request"
+// @Failure 404 {object} dto.APIResponse "Tag not found"
+// @Failure 409 {object} dto.APIResponse "No free slug for this name"
+// @Router /v2/mod/tags/{id}/rename [post]
+func (h *TagHandler) RenameTag(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.RenameTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("name", err.Error()))
+		return
+	}
+
+	result, err := h.serviceV2.RenameTag(c.Request.Context(), id, req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		var apiResponse dto.APIResponse
+
+		switch {
+		case errors.Is(err, domain.ErrInvalidRequest):
+			statusCode = http.StatusBadRequest
+			apiResponse = dto.NewValidationErrorResponse("request", err.Error())
+		case errors.Is(err, domain.ErrCanonicalTagNotFound):
+			statusCode = http.StatusNotFound
+			apiResponse = dto.NewNotFoundResponse("canonical tag", id)
+		case errors.Is(err, domain.ErrSlugTaken):
+			statusCode = http.StatusConflict
+			apiResponse = dto.NewConflictResponse("SLUG_TAKEN", err.Error(), nil)
+		default:
+			apiResponse = dto.NewInternalErrorResponse("Failed to rename tag: " + err.Error())
+		}
+
+		slog.Error("RenameTag failed",
+			"tag_id", id,
+			"name", req.Name,
+			"status_code", statusCode,
+			"error", err.Error(),
+		)
+		c.JSON(statusCode, apiResponse)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(result, fmt.Sprintf("Tag renamed from '%s' to '%s'", result.OldName, result.Tag.Name), nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// GetTagBySlug godoc
+// @Summary Get tag by slug
+// @Description Resolve a slug to its tag. Historic slugs (from renames) resolve to the current tag with redirected=true.
+// @Tags Tags
+// @Produce json
+// @Param slug path string true "Tag slug"
+// @Param lang query string false "Name locale (e.g. vi, en); overrides Accept-Language"
+// @Success 200 {object} dto.TagBySlugResponse
+// @Failure 404 {object} dto.APIResponse
+// @Router /tags/by-slug/{slug} [get]
+func (h *TagHandler) GetTagBySlug(c *gin.Context) {
+	slug := c.Param("slug")
+
+	result, err := h.serviceV2.GetTagBySlug(c.Request.Context(), slug)
+	if err != nil {
+		if errors.Is(err, domain.ErrCanonicalTagNotFound) || errors.Is(err, domain.ErrInvalidRequest) {
+			c.JSON(http.StatusNotFound, dto.NewNotFoundResponse("tag slug", slug))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to get tag by slug: "+err.Error()))
+		return
+	}
+
+	message := "Tag retrieved successfully"
+	if result.Redirected {
+		message = fmt.Sprintf("Slug '%s' moved to '%s'", slug, result.Tag.Slug)
+	}
+	apiResponse := dto.NewSuccessResponse(result, message, nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
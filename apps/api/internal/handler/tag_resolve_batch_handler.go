@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================
+// Batch Tag Resolution Handlers
+// ============================================================
+
+// ResolveTagBatch godoc
+// @Summary Resolve many tags at once
+// @Description Resolve up to 50 tag names in one request (e.g. a pasted comma-separated list).
+// @Description Exact matches use one lookup; only misses are translated and embedded, each in one OpenAI call.
+// @Description Each result shows the layer that matched. With video_id, all resolved tags are linked to the video in one transaction.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param request body dto.ResolveTagBatchRequest true "Tag names and optional video"
+// @Success 200 {object} dto.ResolveTagBatchResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse "Video not found"
+// @Failure 500 {object} dto.APIResponse
+// @Router /v2/mod/tags/resolve-batch [post]
+func (h *TagHandler) ResolveTagBatch(c *gin.Context) {
+	var req dto.ResolveTagBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("request", err.Error()))
+		return
+	}
+
+	result, err := h.serviceV2.ResolveTagBatch(c.Request.Context(), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidRequest):
+			c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("video_id", err.Error()))
+		case errors.Is(err, domain.ErrVideoNotFound):
+			c.JSON(http.StatusNotFound, dto.NewNotFoundResponse("video", *req.VideoID))
+		default:
+			slog.Error("ResolveTagBatch failed", "name_count", len(req.Names), "error", err.Error())
+			c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to resolve tags: "+err.Error()))
+		}
+		return
+	}
+
+	message := fmt.Sprintf("%d resolved (%d new), %d failed", result.ResolvedCount, result.CreatedCount, result.FailedCount)
+	if req.VideoID != nil {
+		message += fmt.Sprintf(", %d linked to video", result.LinkedCount)
+	}
+	c.JSON(http.StatusOK, dto.NewSuccessResponse(result, message, nil))
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"api/internal/dto"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// currentUserID returns the authenticated user's ID set by AuthMiddleware
+// Writes a 401 response and returns false if it is missing or malformed
+func currentUserID(c *gin.Context) (uuid.UUID, bool) {
+	userIDCtx, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User ID not found in context",
+			Code:    http.StatusUnauthorized,
+		})
+		return uuid.Nil, false
+	}
+
+	userID, ok := userIDCtx.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "Invalid user ID format in context",
+			Code:    http.StatusUnauthorized,
+		})
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+// ============================================================
+// Alias Review Queue Handlers
+// ============================================================
+
+// GetAliasReviewQueue godoc
+// @Summary Alias review queue
+// @Description Paginated list of unreviewed aliases (AI auto-merged), lowest similarity first.
+// @Description Each entry shows nearest alternative canonicals and affected videos.
+// @Tags Tags
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} dto.AliasReviewQueueResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /v2/mod/tags/review-queue [get]
+func (h *TagHandler) GetAliasReviewQueue(c *gin.Context) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := parsePositiveInt(p); err == nil {
+			page = parsed
+		}
+	}
+
+	limit := 20
+	if l := c.Query("limit"); l != "" {
+		if parsed, err := parsePositiveInt(l); err == nil {
+			limit = min(parsed, MaxTagSearchLimit)
+		}
+	}
+
+	queue, err := h.serviceV2.GetAliasReviewQueue(c.Request.Context(), page, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to load review queue: "+err.Error()))
+		return
+	}
+
+	metadata := &dto.Metadata{
+		Pagination: &queue.Pagination,
+	}
+	apiResponse := dto.NewSuccessResponse(queue.Data, fmt.Sprintf("%d aliases waiting for review", queue.Pagination.TotalItems), metadata)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// AcceptQueuedAlias godoc
+// @Summary Accept alias from review queue
+// @Description Confirm an alias on its current canonical tag
+// @Tags Tags
+// @Produce json
+// @Param alias_id path string true "Alias ID (UUID)"
+// @Success 200 {object} dto.TagAliasResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Router /v2/mod/tags/review-queue/{alias_id}/accept [post]
+func (h *TagHandler) AcceptQueuedAlias(c *gin.Context) {
+	aliasID := c.Param("alias_id")
+	reviewerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	alias, err := h.serviceV2.AcceptAlias(c.Request.Context(), aliasID, reviewerID)
+	if err != nil {
+		respondAliasError(c, "accept alias", aliasID, err)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(alias, fmt.Sprintf("Alias '%s' accepted", alias.RawText), nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// ReassignQueuedAlias godoc
+// @Summary Reassign alias from review queue
+// @Description Move an alias to another canonical tag and mark it reviewed
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param alias_id path string true "Alias ID (UUID)"
+// @Param request body dto.MoveAliasRequest true "Target canonical tag"
+// @Success 200 {object} dto.TagAliasResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 409 {object} dto.APIResponse
+// @Router /v2/mod/tags/review-queue/{alias_id}/reassign [post]
+func (h *TagHandler) ReassignQueuedAlias(c *gin.Context) {
+	aliasID := c.Param("alias_id")
+	reviewerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.MoveAliasRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("target_id", err.Error()))
+		return
+	}
+
+	alias, err := h.serviceV2.ReassignAlias(c.Request.Context(), aliasID, reviewerID, req)
+	if err != nil {
+		respondAliasError(c, "reassign alias", aliasID, err)
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(alias, fmt.Sprintf("Alias '%s' reassigned", alias.RawText), nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
+
+// SplitQueuedAlias godoc
+// @Summary Split alias from review queue
+// @Description Detach an alias into its own new canonical tag and mark it reviewed
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param alias_id path string true "Alias ID (UUID)"
+// @Param request body dto.SplitAliasRequest false "Optional display name for the new canonical tag"
+// @Success 201 {object} dto.TagResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 404 {object} dto.APIResponse
+// @Failure 409 {object} dto.APIResponse
+// @Router /v2/mod/tags/review-queue/{alias_id}/split [post]
+func (h *TagHandler) SplitQueuedAlias(c *gin.Context) {
+	aliasID := c.Param("alias_id")
+	reviewerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.SplitAliasRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("display_name", err.Error()))
+			return
+		}
+	}
+
+	tag, err := h.serviceV2.SplitReviewedAlias(c.Request.Context(), aliasID, reviewerID, req)
+	if err != nil {
+		respondAliasError(c, "split alias", aliasID, err)
+		return
+	}
+
+	apiResponse := dto.NewCreatedResponse(tag, fmt.Sprintf("Alias split into new tag '%s'", tag.Name), nil)
+	c.JSON(http.StatusCreated, apiResponse)
+}
+
+// GetAliasReviewStats godoc
+// @Summary Alias review throughput per mod
+// @Description Number of aliases accepted, reassigned and split by each mod over the last N days
+// @Tags Tags
+// @Produce json
+// @Param days query int false "Window in days" default(7)
+// @Success 200 {object} dto.AliasReviewStatsResponse
+// @Failure 400 {object} dto.APIResponse
+// @Failure 500 {object} dto.APIResponse
+// @Router /v2/mod/tags/review-queue/stats [get]
+func (h *TagHandler) GetAliasReviewStats(c *gin.Context) {
+	days := 7
+	if d := c.Query("days"); d != "" {
+		parsed, err := strconv.Atoi(d)
+		if err != nil || parsed < 1 {
+			c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("days", "days must be a positive integer"))
+			return
+		}
+		days = parsed
+	}
+
+	stats, err := h.serviceV2.GetAliasReviewStats(c.Request.Context(), days)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to load review stats: "+err.Error()))
+		return
+	}
+
+	apiResponse := dto.NewSuccessResponse(stats, "Review stats retrieved successfully", nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================
+// Taxonomy Export / Import Handlers
+// ============================================================
+
+// ExportTaxonomy godoc
+// @Summary Export tag taxonomy
+// @Description Download all canonical tags (slug, display name, approval, parent, aliases with language)
+// @Description as a versioned JSON file. The file is returned as-is (not wrapped) so it can be imported directly.
+// @Tags Tags
+// @Produce json
+// @Param include_embeddings query bool false "Include alias embeddings (large)"
+// @Success 200 {object} dto.TaxonomyFile
+// @Failure 500 {object} dto.APIResponse
+// @Router /v2/admin/tags/export [get]
+func (h *TagHandler) ExportTaxonomy(c *gin.Context) {
+	includeEmbeddings := c.Query("include_embeddings") == "true"
+
+	file, err := h.serviceV2.ExportTaxonomy(c.Request.Context(), includeEmbeddings)
+	if err != nil {
+		slog.Error("ExportTaxonomy failed", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, dto.NewInternalErrorResponse("Failed to export taxonomy: "+err.Error()))
+		return
+	}
+
+	filename := fmt.Sprintf("tag-taxonomy-%s.json", file.ExportedAt.Format("20060102-150405"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.JSON(http.StatusOK, file)
+}
+
+// ImportTaxonomy godoc
+// @Summary Import tag taxonomy
+// @Description Upsert canonical tags by slug (current or historic) and aliases by normalized text.
+// @Description Aliases already pointing to another canonical are reported as conflicts, never moved.
+// @Description Missing embeddings are regenerated after the import. Use dry_run=true to only get the report.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param dry_run query bool false "Report only, write nothing"
+// @Param request body dto.TaxonomyFile true "Taxonomy file (from export)"
+// @Success 200 {object} dto.TaxonomyImportResponse
+// @Failure 400 {object} dto.APIResponse "Invalid file or unsupported version"
+// @Failure 500 {object} dto.APIResponse
+// @Router /v2/admin/tags/import [post]
+func (h *TagHandler) ImportTaxonomy(c *gin.Context) {
+	dryRun := c.Query("dry_run") == "true"
+
+	var file dto.TaxonomyFile
+	if err := c.ShouldBindJSON(&file); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse("file", err.Error()))
+		return
+	}
+
+	result, err := h.serviceV2.ImportTaxonomy(c.Request.Context(), file, dryRun)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		var apiResponse dto.APIResponse
+
+		switch {
+		case errors.Is(err, domain.ErrInvalidRequest):
+			statusCode = http.StatusBadRequest
+			apiResponse = dto.NewValidationErrorResponse("file", err.Error())
+		default:
+			apiResponse = dto.NewInternalErrorResponse("Failed to import taxonomy: " + err.Error())
+		}
+
+		slog.Error("ImportTaxonomy failed",
+			"tags", len(file.Tags),
+			"dry_run", dryRun,
+			"status_code", statusCode,
+			"error", err.Error(),
+		)
+		c.JSON(statusCode, apiResponse)
+		return
+	}
+
+	message := fmt.Sprintf("Imported %d tags: %d created, %d updated, %d conflicts",
+		len(file.Tags), result.TagsCreated, result.TagsUpdated, len(result.Conflicts))
+	if dryRun {
+		message = "Dry run: " + message
+	}
+	apiResponse := dto.NewSuccessResponse(result, message, nil)
+	c.JSON(http.StatusOK, apiResponse)
+}
@@ -3,7 +3,10 @@ package handler
 import (
 	"api/internal/domain"
 	"api/internal/dto"
+	"api/internal/helper"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -27,6 +30,7 @@ func NewVideoHandler(service domain.VideoService) *VideoHandler {
 // @Param limit query int false "Items per page" default(10) minimum(1) maximum(50)
 // @Param sort query string false "Sort order" Enums(newest, popular, views)
 // @Param tag_id query string false "Filter by tag ID (UUID)"
+// @Param include_descendants query bool false "With tag_id: also include videos tagged with descendant tags"
 // @Success 200 {object} dto.VideoListResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -62,13 +66,15 @@ func (h *VideoHandler) GetVideoList(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Video ID (UUID)"
+// @Param lang query string false "Tag name locale (e.g. vi, en); overrides Accept-Language"
+// @Param Accept-Language header string false "Preferred tag name locales"
 // @Success 200 {object} dto.VideoDetailResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Router /videos/{id} [get]
 func (h *VideoHandler) GetVideoDetail(c *gin.Context) {
 	id := c.Param("id")
 
-	response, err := h.service.GetVideoDetail(id)
+	response, err := h.service.GetVideoDetail(id, domain.LocalesFromContext(c.Request.Context()))
 	if err != nil {
 		c.JSON(http.StatusNotFound, dto.ErrorResponse{
 			Error:   "Video not found",
@@ -199,7 +205,7 @@ func (h *VideoHandler) CreateSegment(c *gin.Context) {
 // @Produce json
 // @Param page query int false "Page number" default(1) minimum(1)
 // @Param page_size query int false "Items per page" default(10) minimum(1) maximum(50)
-// @Param q query string false "Search by title"
+// @Param q query string false "Search by title or description"
 // @Param tag_ids query string false "Filter by tag IDs (comma-separated)"
 // @Param has_transcript query string false "Filter by transcript status" Enums(all,true,false)
 // @Success 200 {object} dto.ModVideoListResponse
@@ -252,10 +258,10 @@ func (h *VideoHandler) GetModVideoList(c *gin.Context) {
 // @Tags Videos
 // @Accept json
 // @Produce json
-// @Param request body dto.CreateVideoRequest true "YouTube video ID"
+// @Param request body dto.CreateVideoRequest true "YouTube video ID or URL (watch, youtu.be, shorts, embed, live, music)"
 // @Success 201 {object} dto.VideoCreateResponse
 // @Failure 400 {object} dto.ErrorResponse
-// @Failure 409 {object} dto.ErrorResponse "Video already exists"
+// @Failure 409 {object} dto.VideoDuplicateResponse "Video already exists (deleted=true: restore it instead)"
 // @Router /mod/videos [post]
 func (h *VideoHandler) CreateVideo(c *gin.Context) {
 	var req dto.CreateVideoRequest
@@ -270,10 +276,32 @@ func (h *VideoHandler) CreateVideo(c *gin.Context) {
 
 	response, err := h.service.CreateVideo(req)
 	if err != nil {
+		var existsErr *domain.VideoExistsError
+		if errors.As(err, &existsErr) {
+			duplicate := dto.VideoDuplicateResponse{
+				ErrorResponse: dto.ErrorResponse{
+					Error:   "Video already exists",
+					Message: err.Error(),
+					Code:    http.StatusConflict,
+				},
+				ExistingVideo: helper.ToVideoCardResponse(existsErr.Video),
+				Deleted:       existsErr.Deleted,
+			}
+			if existsErr.Deleted {
+				duplicate.RestorePath = "/api/v1/mod/videos/" + existsErr.Video.ID.String() + "/restore"
+			}
+			c.JSON(http.StatusConflict, duplicate)
+			return
+		}
+
 		statusCode := http.StatusInternalServerError
-		errMsg := err.Error()
-		if len(errMsg) > 5 && errMsg[:5] == "video" {
+		switch {
+		case errors.Is(err, domain.ErrInvalidYouTubeInput):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, domain.ErrVideoExists):
 			statusCode = http.StatusConflict
+		default:
+			slog.Error("CreateVideo failed", "youtube_id", req.YoutubeID, "error", err.Error())
 		}
 		c.JSON(statusCode, dto.ErrorResponse{
 			Error:   "Failed to create video",
@@ -288,20 +316,25 @@ func (h *VideoHandler) CreateVideo(c *gin.Context) {
 
 // PreviewVideo godoc
 // @Summary Preview YouTube video metadata
-// @Description Fetch YouTube metadata without saving (mod/admin only)
+// @Description Fetch YouTube metadata without saving (mod/admin only).
+// @Description Pass an ID in the path, or any YouTube URL as ?url= on /mod/videos/preview. existing_video_id is set when the video is already stored.
 // @Tags Videos
 // @Accept json
 // @Produce json
 // @Param id path string true "YouTube video ID"
+// @Param url query string false "YouTube video URL (on /mod/videos/preview)"
 // @Success 200 {object} dto.VideoCreateResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Router /mod/videos/preview/{id} [get]
 func (h *VideoHandler) PreviewVideo(c *gin.Context) {
 	youtubeID := c.Param("id")
+	if youtubeID == "" {
+		youtubeID = c.Query("url")
+	}
 	if youtubeID == "" {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
 			Error:   "Missing YouTube ID",
-			Message: "YouTube video ID is required",
+			Message: "YouTube video ID or url query parameter is required",
 			Code:    http.StatusBadRequest,
 		})
 		return
@@ -321,6 +354,56 @@ func (h *VideoHandler) PreviewVideo(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// UpdateVideo godoc
+// @Summary Edit video metadata
+// @Description Change title, description, thumbnail, duration or publish date (mod/admin only). Only the fields sent are changed.
+// @Description Send the updated_at from the last read: 409 if the video changed since. Edited fields are kept by the scheduled
+// @Description YouTube refresh (see manual_fields) until listed in unlock_fields.
+// @Tags Videos
+// @Accept json
+// @Produce json
+// @Param id path string true "Video ID (UUID)"
+// @Param request body dto.UpdateVideoRequest true "Fields to change"
+// @Success 200 {object} dto.ModVideoResponse
+// @Failure 400 {object} dto.ErrorResponse "Invalid field values"
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse "Modified since updated_at"
+// @Router /mod/videos/{id} [patch]
+func (h *VideoHandler) UpdateVideo(c *gin.Context) {
+	var req dto.UpdateVideoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	response, err := h.service.UpdateVideo(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domain.ErrInvalidRequest):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, domain.ErrVideoNotFound):
+			statusCode = http.StatusNotFound
+		case errors.Is(err, domain.ErrVideoModified):
+			statusCode = http.StatusConflict
+		default:
+			slog.Error("UpdateVideo failed", "video_id", c.Param("id"), "error", err.Error())
+		}
+		c.JSON(statusCode, dto.ErrorResponse{
+			Error:   "Failed to update video",
+			Message: err.Error(),
+			Code:    statusCode,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
 // DeleteVideo godoc
 // @Summary Delete video (soft delete)
 // @Description Soft delete a video (mod/admin only)
@@ -346,9 +429,41 @@ func (h *VideoHandler) DeleteVideo(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// RestoreVideo godoc
+// @Summary Restore a deleted video
+// @Description Undo a soft delete; tags and transcript are kept (mod/admin only)
+// @Tags Videos
+// @Produce json
+// @Param id path string true "Video ID (UUID)"
+// @Success 200 {object} dto.VideoCreateResponse
+// @Failure 404 {object} dto.ErrorResponse "No deleted video with this ID"
+// @Router /mod/videos/{id}/restore [post]
+func (h *VideoHandler) RestoreVideo(c *gin.Context) {
+	response, err := h.service.RestoreVideo(c.Param("id"))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domain.ErrInvalidRequest):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, domain.ErrVideoNotFound):
+			statusCode = http.StatusNotFound
+		default:
+			slog.Error("RestoreVideo failed", "video_id", c.Param("id"), "error", err.Error())
+		}
+		c.JSON(statusCode, dto.ErrorResponse{
+			Error:   "Failed to restore video",
+			Message: err.Error(),
+			Code:    statusCode,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
 // SearchVideos godoc
 // @Summary Search videos
-// @Description Search videos by title (mod/admin only)
+// @Description Search videos by title and description (mod/admin only)
 // @Tags Videos
 // @Accept json
 // @Produce json
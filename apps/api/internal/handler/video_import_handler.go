@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================
+// Video Bulk Import Handlers
+// ============================================================
+
+// respondImportError maps import errors to HTTP responses
+func respondImportError(c *gin.Context, action string, err error) {
+	statusCode := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrInvalidImport), errors.Is(err, domain.ErrInvalidYouTubeInput):
+		statusCode = http.StatusBadRequest
+	case errors.Is(err, domain.ErrImportJobNotFound):
+		statusCode = http.StatusNotFound
+	case errors.Is(err, domain.ErrYouTubeUnavailable):
+		statusCode = http.StatusServiceUnavailable
+	default:
+		slog.Error("Video import request failed", "action", action, "error", err.Error())
+	}
+
+	c.JSON(statusCode, dto.ErrorResponse{
+		Error:   "Failed to " + action,
+		Message: err.Error(),
+		Code:    statusCode,
+	})
+}
+
+// CreateVideoImport godoc
+// @Summary Bulk import videos from YouTube
+// @Description Import all uploads of a channel, all items of a playlist, or a list of video URLs/IDs (exactly one source).
+// @Description Runs as a background job: IDs are paged 50 at a time, existing videos (including soft-deleted) are skipped.
+// @Description Poll GET /mod/imports/{id} for progress. Channel and playlist imports need YOUTUBE_API_KEY.
+// @Tags Videos
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateVideoImportRequest true "Import source"
+// @Success 202 {object} dto.VideoImportJobResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse "YouTube API not configured"
+// @Router /mod/imports [post]
+func (h *VideoHandler) CreateVideoImport(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateVideoImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	job, err := h.service.StartVideoImport(c.Request.Context(), req, userID)
+	if err != nil {
+		respondImportError(c, "start import", err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, job)
+}
+
+// GetVideoImport godoc
+// @Summary Get import job progress
+// @Tags Videos
+// @Produce json
+// @Param id path string true "Import job ID (UUID)"
+// @Success 200 {object} dto.VideoImportJobResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /mod/imports/{id} [get]
+func (h *VideoHandler) GetVideoImport(c *gin.Context) {
+	job, err := h.service.GetVideoImport(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		respondImportError(c, "get import", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
+
+// ListVideoImports godoc
+// @Summary List import jobs
+// @Description Import jobs, newest first
+// @Tags Videos
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} dto.VideoImportJobListResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /mod/imports [get]
+func (h *VideoHandler) ListVideoImports(c *gin.Context) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := parsePositiveInt(p); err == nil {
+			page = parsed
+		}
+	}
+
+	limit := 20
+	if l := c.Query("limit"); l != "" {
+		if parsed, err := parsePositiveInt(l); err == nil {
+			limit = min(parsed, 100)
+		}
+	}
+
+	jobs, err := h.service.ListVideoImports(c.Request.Context(), page, limit)
+	if err != nil {
+		respondImportError(c, "list imports", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jobs)
+}
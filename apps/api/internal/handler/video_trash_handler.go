@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================
+// Video Trash Handlers (admin)
+// ============================================================
+
+// ListTrashedVideos godoc
+// @Summary List deleted videos
+// @Description Soft-deleted videos, most recently deleted first. Videos are purged automatically after VIDEO_TRASH_RETENTION_DAYS (admin only).
+// @Tags Videos
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} dto.TrashedVideoListResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /admin/videos/trash [get]
+func (h *VideoHandler) ListTrashedVideos(c *gin.Context) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := parsePositiveInt(p); err == nil {
+			page = parsed
+		}
+	}
+
+	limit := 20
+	if l := c.Query("limit"); l != "" {
+		if parsed, err := parsePositiveInt(l); err == nil {
+			limit = min(parsed, 100)
+		}
+	}
+
+	videos, err := h.service.ListTrashedVideos(c.Request.Context(), page, limit)
+	if err != nil {
+		slog.Error("ListTrashedVideos failed", "error", err.Error())
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Failed to list deleted videos",
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, videos)
+}
+
+// PurgeVideo godoc
+// @Summary Permanently delete a video
+// @Description Hard delete a soft-deleted video with its transcript segments, tag links, reviews and tag proposals.
+// @Description Frees the YouTube ID so the video can be added again. Cannot be undone (admin only).
+// @Tags Videos
+// @Produce json
+// @Param id path string true "Video ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse "Not in the trash"
+// @Router /admin/videos/trash/{id} [delete]
+func (h *VideoHandler) PurgeVideo(c *gin.Context) {
+	if err := h.service.PurgeVideo(c.Request.Context(), c.Param("id")); err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domain.ErrInvalidRequest):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, domain.ErrVideoNotFound):
+			statusCode = http.StatusNotFound
+		default:
+			slog.Error("PurgeVideo failed", "video_id", c.Param("id"), "error", err.Error())
+		}
+		c.JSON(statusCode, dto.ErrorResponse{
+			Error:   "Failed to purge video",
+			Message: err.Error(),
+			Code:    statusCode,
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -3,14 +3,16 @@ package helper
 import (
 	"api/internal/domain"
 	"api/internal/dto"
-	"encoding/json"
+	"api/internal/infrastructure"
+	"context"
 	"fmt"
-	"net/http"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 // ToVideoCardResponse converts a domain.Video to a dto.VideoCardResponse.
@@ -28,12 +30,15 @@ func ToVideoCardResponse(video *domain.Video) dto.VideoCardResponse {
 }
 
 // ToVideoDetailResponse converts a domain.Video to a dto.VideoDetailResponse.
-func ToVideoDetailResponse(video *domain.Video, reviewCount int) *dto.VideoDetailResponse {
+// Tag names are picked from displayNames (tagID → locale → name) for the given locales.
+func ToVideoDetailResponse(video *domain.Video, reviewCount int, displayNames map[uuid.UUID]map[string]string, locales []string) *dto.VideoDetailResponse {
 	tags := make([]dto.TagResponse, len(video.CanonicalTags))
 	for i, tag := range video.CanonicalTags {
+		name, locale := domain.LocalizedDisplayName(displayNames[tag.ID], locales, tag.DisplayName)
 		tags[i] = dto.TagResponse{
-			ID:   tag.ID.String(),
-			Name: tag.DisplayName,
+			ID:     tag.ID.String(),
+			Name:   name,
+			Locale: locale,
 		}
 	}
 
@@ -41,8 +46,9 @@ func ToVideoDetailResponse(video *domain.Video, reviewCount int) *dto.VideoDetai
 	cardResponse.ReviewCount = reviewCount
 
 	return &dto.VideoDetailResponse{
-		VideoCardResponse: cardResponse,
-		Tags:              tags,
+		VideoCardResponse:     cardResponse,
+		VideoMetadataResponse: ToVideoMetadataResponse(video),
+		Tags:                  tags,
 	}
 }
 
@@ -71,7 +77,7 @@ func FetchYouTubeMetadata(youtubeID string) (*dto.YouTubeVideoInfo, error) {
 		// Fallback: create placeholder metadata if no API key
 		return &dto.YouTubeVideoInfo{
 			ID:           youtubeID,
-			Title:        fmt.Sprintf("Video %s (pending metadata)", youtubeID),
+			Title:        fmt.Sprintf("Video %s %s", youtubeID, domain.VideoPlaceholderTitleSuffix),
 			PublishedAt:  time.Now(),
 			Duration:     0,
 			ViewCount:    0,
@@ -79,81 +85,63 @@ func FetchYouTubeMetadata(youtubeID string) (*dto.YouTubeVideoInfo, error) {
 		}, nil
 	}
 
-	url := fmt.Sprintf(
-		"https://www.googleapis.com/youtube/v3/videos?part=snippet,contentDetails,statistics&id=%s&key=%s",
-		youtubeID, apiKey,
-	)
-
-	resp, err := http.Get(url)
+	client, err := infrastructure.NewYouTubeClient()
 	if err != nil {
-		return nil, fmt.Errorf("failed to call YouTube API: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("YouTube API returned status: %d", resp.StatusCode)
-	}
-
-	var result struct {
-		Items []struct {
-			ID      string `json:"id"`
-			Snippet struct {
-				Title       string `json:"title"`
-				PublishedAt string `json:"publishedAt"`
-				Thumbnails  struct {
-					High struct {
-						URL string `json:"url"`
-					} `json:"high"`
-					Maxres struct {
-						URL string `json:"url"`
-					} `json:"maxres"`
-				} `json:"thumbnails"`
-			} `json:"snippet"`
-			ContentDetails struct {
-				Duration string `json:"duration"` // ISO 8601 format: PT1H2M3S
-			} `json:"contentDetails"`
-			Statistics struct {
-				ViewCount string `json:"viewCount"`
-			} `json:"statistics"`
-		} `json:"items"`
-	}
-
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode YouTube response: %w", err)
+	items, err := client.GetVideos(context.Background(), []string{youtubeID})
+	if err != nil {
+		return nil, err
 	}
-
-	if len(result.Items) == 0 {
+	if len(items) == 0 {
 		return nil, fmt.Errorf("video not found on YouTube")
 	}
 
-	item := result.Items[0]
+	item := items[0]
+	return &dto.YouTubeVideoInfo{
+		ID:                    item.ID,
+		Title:                 item.Title,
+		PublishedAt:           item.PublishedAt,
+		Duration:              ParseDuration(item.Duration),
+		ViewCount:             item.ViewCount,
+		ThumbnailURL:          item.ThumbnailURL,
+		VideoMetadataResponse: ToVideoMetadataFromYouTube(item),
+	}, nil
+}
 
-	// Parse published date
-	publishedAt, err := time.Parse(time.RFC3339, item.Snippet.PublishedAt)
-	if err != nil {
-		publishedAt = time.Now()
+// ToVideoMetadataFromYouTube extracts the extra metadata of a YouTube API video.
+func ToVideoMetadataFromYouTube(item infrastructure.YouTubeVideo) dto.VideoMetadataResponse {
+	return dto.VideoMetadataResponse{
+		Description:          item.Description,
+		ChannelID:            item.ChannelID,
+		ChannelTitle:         item.ChannelTitle,
+		DefaultAudioLanguage: item.DefaultAudioLanguage,
+		CategoryID:           item.CategoryID,
+		HasCaptions:          item.HasCaptions,
 	}
+}
 
-	// Parse duration (ISO 8601 to seconds)
-	duration := ParseDuration(item.ContentDetails.Duration)
-
-	// Parse view count
-	viewCount, _ := strconv.Atoi(item.Statistics.ViewCount)
-
-	// Get best thumbnail
-	thumbnailURL := item.Snippet.Thumbnails.High.URL
-	if item.Snippet.Thumbnails.Maxres.URL != "" {
-		thumbnailURL = item.Snippet.Thumbnails.Maxres.URL
+// ToVideoMetadataResponse converts the extra YouTube metadata of a domain.Video.
+func ToVideoMetadataResponse(video *domain.Video) dto.VideoMetadataResponse {
+	return dto.VideoMetadataResponse{
+		Description:          video.Description,
+		ChannelID:            video.ChannelID,
+		ChannelTitle:         video.ChannelTitle,
+		DefaultAudioLanguage: video.DefaultAudioLanguage,
+		CategoryID:           video.CategoryID,
+		HasCaptions:          video.HasCaptions,
 	}
+}
 
-	return &dto.YouTubeVideoInfo{
-		ID:           item.ID,
-		Title:        item.Snippet.Title,
-		PublishedAt:  publishedAt,
-		Duration:     duration,
-		ViewCount:    viewCount,
-		ThumbnailURL: thumbnailURL,
-	}, nil
+// ApplyVideoMetadata copies extra YouTube metadata onto a domain.Video.
+func ApplyVideoMetadata(video *domain.Video, metadata dto.VideoMetadataResponse) {
+	video.Description = metadata.Description
+	video.ChannelID = metadata.ChannelID
+	video.ChannelTitle = metadata.ChannelTitle
+	video.DefaultAudioLanguage = metadata.DefaultAudioLanguage
+	video.CategoryID = metadata.CategoryID
+	video.HasCaptions = metadata.HasCaptions
 }
 
 // ParseDuration converts ISO 8601 duration (PT1H2M3S) to seconds.
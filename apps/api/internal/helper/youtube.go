@@ -0,0 +1,54 @@
+package helper
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// youtubeIDPattern matches an 11-character YouTube video ID
+var youtubeIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+
+// youtubePathPrefixes are the URL paths that carry the video ID as the next segment
+// e.g. /shorts/ID, /embed/ID, /live/ID
+var youtubePathPrefixes = []string{"/shorts/", "/embed/", "/live/", "/v/", "/e/"}
+
+// ParseYouTubeID extracts the video ID from a bare ID or a YouTube URL
+// Supports watch (youtube.com, m., music.), youtu.be, shorts, embed (incl. youtube-nocookie.com) and live URLs;
+// extra parameters such as t= and list= are ignored. Returns false when no valid ID can be found
+func ParseYouTubeID(input string) (string, bool) {
+	input = strings.TrimSpace(input)
+	if youtubeIDPattern.MatchString(input) {
+		return input, true
+	}
+
+	if !strings.Contains(input, "://") {
+		input = "https://" + input
+	}
+	u, err := url.Parse(input)
+	if err != nil {
+		return "", false
+	}
+
+	var id string
+	switch strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.") {
+	case "youtube.com", "m.youtube.com", "music.youtube.com", "youtube-nocookie.com":
+		if u.Path == "/watch" || u.Path == "/watch/" {
+			id = u.Query().Get("v")
+			break
+		}
+		for _, prefix := range youtubePathPrefixes {
+			if rest, ok := strings.CutPrefix(u.Path, prefix); ok {
+				id, _, _ = strings.Cut(rest, "/")
+				break
+			}
+		}
+	case "youtu.be":
+		id, _, _ = strings.Cut(strings.TrimPrefix(u.Path, "/"), "/")
+	}
+
+	if !youtubeIDPattern.MatchString(id) {
+		return "", false
+	}
+	return id, true
+}
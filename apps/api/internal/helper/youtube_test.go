@@ -0,0 +1,49 @@
+package helper
+
+import "testing"
+
+func TestParseYouTubeID(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		wantID string
+		wantOK bool
+	}{
+		{"bare ID", "dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"bare ID with spaces", "  dQw4w9WgXcQ  ", "dQw4w9WgXcQ", true},
+		{"watch URL", "https://www.youtube.com/watch?v=dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"watch URL with extra params", "https://www.youtube.com/watch?list=PL123&v=dQw4w9WgXcQ&t=42s", "dQw4w9WgXcQ", true},
+		{"watch URL without scheme", "youtube.com/watch?v=dQw4w9WgXcQ&feature=share", "dQw4w9WgXcQ", true},
+		{"mobile watch URL", "https://m.youtube.com/watch?v=dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"music watch URL", "https://music.youtube.com/watch?v=dQw4w9WgXcQ&si=abc", "dQw4w9WgXcQ", true},
+		{"youtu.be", "https://youtu.be/dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"youtu.be with time", "https://youtu.be/dQw4w9WgXcQ?t=10", "dQw4w9WgXcQ", true},
+		{"youtu.be without scheme", "youtu.be/dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"shorts", "https://www.youtube.com/shorts/dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"shorts with params", "https://youtube.com/shorts/dQw4w9WgXcQ?feature=share", "dQw4w9WgXcQ", true},
+		{"embed", "https://www.youtube.com/embed/dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"nocookie embed", "https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"live", "https://www.youtube.com/live/dQw4w9WgXcQ?si=x", "dQw4w9WgXcQ", true},
+
+		{"empty", "", "", false},
+		{"too short ID", "dQw4w9WgXc", "", false},
+		{"too long ID", "dQw4w9WgXcQQ", "", false},
+		{"invalid characters", "dQw4w9WgX!Q", "", false},
+		{"watch without v", "https://www.youtube.com/watch?list=PL123", "", false},
+		{"watch with bad v", "https://www.youtube.com/watch?v=short", "", false},
+		{"channel URL", "https://www.youtube.com/@somechannel", "", false},
+		{"other host", "https://vimeo.com/watch?v=dQw4w9WgXcQ", "", false},
+		{"lookalike host", "https://notyoutube.com/watch?v=dQw4w9WgXcQ", "", false},
+		{"youtu.be without ID", "https://youtu.be/", "", false},
+		{"shorts without ID", "https://www.youtube.com/shorts/", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := ParseYouTubeID(tt.input)
+			if id != tt.wantID || ok != tt.wantOK {
+				t.Errorf("ParseYouTubeID(%q) = (%q, %v), want (%q, %v)", tt.input, id, ok, tt.wantID, tt.wantOK)
+			}
+		})
+	}
+}
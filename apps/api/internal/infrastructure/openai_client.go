@@ -2,6 +2,7 @@ package infrastructure
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 
@@ -134,3 +135,57 @@ func (c *OpenAIClient) TranslateToEnglish(ctx context.Context, text string) (str
 
 	return resp.Choices[0].Message.Content, nil
 }
+
+// BatchTranslateToEnglish translates many terms to English in one GPT-4o-mini call
+// Result order matches texts; an empty string means the model returned no translation for that term
+func (c *OpenAIClient) BatchTranslateToEnglish(ctx context.Context, texts []string) ([]string, error) {
+	if len(texts) == 0 {
+		return []string{}, nil
+	}
+
+	const BATCH_TRANS_SYS_PROMPT = "You are an expert terminologist. You receive a JSON object {\"terms\": [...]} of Vietnamese (or other non-English) tags. Translate each term into its most standard, professional, and academic English equivalent.\nRules:\n1. Reply with a JSON object {\"translations\": [...]} with exactly one string per input term, in the same order.\n2. Prioritize established terminology (e.g., 'Pragmatism' instead of 'Practicalism').\n3. Preserve proper nouns.\n4. Do not add punctuation or explanations."
+
+	input, err := json.Marshal(map[string][]string{"terms": texts})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode terms: %w", err)
+	}
+
+	resp, err := c.client.CreateChatCompletion(
+		ctx,
+		openai.ChatCompletionRequest{
+			Model: openai.GPT4oMini,
+			Messages: []openai.ChatCompletionMessage{
+				{
+					Role:    openai.ChatMessageRoleSystem,
+					Content: BATCH_TRANS_SYS_PROMPT,
+				},
+				{
+					Role:    openai.ChatMessageRoleUser,
+					Content: string(input),
+				},
+			},
+			ResponseFormat: &openai.ChatCompletionResponseFormat{Type: openai.ChatCompletionResponseFormatTypeJSONObject},
+			MaxTokens:      20*len(texts) + 20, // Same per-term budget as TranslateToEnglish
+			Temperature:    0.0,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("batch translation error: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, fmt.Errorf("no translation returned")
+	}
+
+	var output struct {
+		Translations []string `json:"translations"`
+	}
+	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &output); err != nil {
+		return nil, fmt.Errorf("invalid batch translation response: %w", err)
+	}
+	if len(output.Translations) != len(texts) {
+		return nil, fmt.Errorf("expected %d translations, got %d", len(texts), len(output.Translations))
+	}
+
+	return output.Translations, nil
+}
@@ -0,0 +1,264 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// YouTubeMaxResults is the page size limit of the YouTube Data API (playlistItems and videos)
+const YouTubeMaxResults = 50
+
+// YouTubeClient calls the YouTube Data API v3
+type YouTubeClient struct {
+	apiKey     string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// YouTubeVideo is the metadata of one video returned by the videos endpoint
+type YouTubeVideo struct {
+	ID           string
+	Title        string
+	PublishedAt  time.Time
+	Duration     string // ISO 8601 (PT1H2M3S)
+	ViewCount    int
+	ThumbnailURL string
+
+	Description          string
+	ChannelID            string
+	ChannelTitle         string
+	DefaultAudioLanguage string // BCP-47, empty when the channel did not set it
+	CategoryID           string // YouTube video category ID (e.g. 27 = Education)
+	HasCaptions          bool   // Captions exist on YouTube (contentDetails.caption)
+
+	PrivacyStatus string // public | unlisted | private
+	UploadStatus  string // processed | uploaded | deleted | failed | rejected
+}
+
+// Available reports whether the video can be watched (YouTube still lists some removed videos)
+func (v YouTubeVideo) Available() bool {
+	switch v.UploadStatus {
+	case "deleted", "failed", "rejected":
+		return false
+	}
+	return v.PrivacyStatus != "private"
+}
+
+// YouTubePlaylistPage is one page of video IDs of a playlist
+type YouTubePlaylistPage struct {
+	VideoIDs      []string
+	TotalResults  int
+	NextPageToken string // Empty on the last page
+}
+
+// NewYouTubeClient creates a client from the environment
+// Sử dụng YOUTUBE_API_KEY và YOUTUBE_API_BASE_URL (tùy chọn, ví dụ trỏ tới fake server khi test)
+func NewYouTubeClient() (*YouTubeClient, error) {
+	apiKey := os.Getenv("YOUTUBE_API_KEY")
+	if apiKey == "" {
+		return nil, fmt.Errorf("YOUTUBE_API_KEY environment variable not set")
+	}
+
+	baseURL := os.Getenv("YOUTUBE_API_BASE_URL")
+	if baseURL == "" {
+		baseURL = "https://www.googleapis.com/youtube/v3" // Default YouTube endpoint
+	}
+
+	return NewYouTubeClientWithConfig(apiKey, baseURL, &http.Client{Timeout: 15 * time.Second}), nil
+}
+
+// NewYouTubeClientWithConfig creates a client with an explicit base URL and HTTP client
+func NewYouTubeClientWithConfig(apiKey, baseURL string, httpClient *http.Client) *YouTubeClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &YouTubeClient{
+		apiKey:     apiKey,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// GetChannelUploadsPlaylist returns the ID of the playlist holding all uploads of a channel
+func (c *YouTubeClient) GetChannelUploadsPlaylist(ctx context.Context, channelID string) (string, error) {
+	var result struct {
+		Items []struct {
+			ContentDetails struct {
+				RelatedPlaylists struct {
+					Uploads string `json:"uploads"`
+				} `json:"relatedPlaylists"`
+			} `json:"contentDetails"`
+		} `json:"items"`
+	}
+
+	params := url.Values{"part": {"contentDetails"}, "id": {channelID}}
+	if err := c.get(ctx, "channels", params, &result); err != nil {
+		return "", err
+	}
+	if len(result.Items) == 0 || result.Items[0].ContentDetails.RelatedPlaylists.Uploads == "" {
+		return "", fmt.Errorf("channel '%s' not found on YouTube", channelID)
+	}
+	return result.Items[0].ContentDetails.RelatedPlaylists.Uploads, nil
+}
+
+// GetPlaylistPage returns one page (up to 50) of video IDs of a playlist
+// Pass the previous page's NextPageToken to continue; "" starts at the first page
+func (c *YouTubeClient) GetPlaylistPage(ctx context.Context, playlistID, pageToken string) (*YouTubePlaylistPage, error) {
+	var result struct {
+		NextPageToken string `json:"nextPageToken"`
+		PageInfo      struct {
+			TotalResults int `json:"totalResults"`
+		} `json:"pageInfo"`
+		Items []struct {
+			ContentDetails struct {
+				VideoID string `json:"videoId"`
+			} `json:"contentDetails"`
+		} `json:"items"`
+	}
+
+	params := url.Values{
+		"part":       {"contentDetails"},
+		"playlistId": {playlistID},
+		"maxResults": {strconv.Itoa(YouTubeMaxResults)},
+	}
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if err := c.get(ctx, "playlistItems", params, &result); err != nil {
+		return nil, err
+	}
+
+	page := &YouTubePlaylistPage{
+		VideoIDs:      make([]string, 0, len(result.Items)),
+		TotalResults:  result.PageInfo.TotalResults,
+		NextPageToken: result.NextPageToken,
+	}
+	for _, item := range result.Items {
+		if item.ContentDetails.VideoID != "" {
+			page.VideoIDs = append(page.VideoIDs, item.ContentDetails.VideoID)
+		}
+	}
+	return page, nil
+}
+
+// GetVideos returns metadata of up to 50 videos in one call (1 quota unit)
+// Videos that are private, deleted or unknown are usually absent from the result
+func (c *YouTubeClient) GetVideos(ctx context.Context, ids []string) ([]YouTubeVideo, error) {
+	if len(ids) == 0 {
+		return []YouTubeVideo{}, nil
+	}
+	if len(ids) > YouTubeMaxResults {
+		return nil, fmt.Errorf("at most %d video IDs per call, got %d", YouTubeMaxResults, len(ids))
+	}
+
+	var result struct {
+		Items []struct {
+			ID      string `json:"id"`
+			Snippet struct {
+				Title                string `json:"title"`
+				Description          string `json:"description"`
+				ChannelID            string `json:"channelId"`
+				ChannelTitle         string `json:"channelTitle"`
+				DefaultAudioLanguage string `json:"defaultAudioLanguage"`
+				CategoryID           string `json:"categoryId"`
+				PublishedAt          string `json:"publishedAt"`
+				Thumbnails           struct {
+					High struct {
+						URL string `json:"url"`
+					} `json:"high"`
+					Maxres struct {
+						URL string `json:"url"`
+					} `json:"maxres"`
+				} `json:"thumbnails"`
+			} `json:"snippet"`
+			ContentDetails struct {
+				Duration string `json:"duration"`
+				Caption  string `json:"caption"` // "true" | "false"
+			} `json:"contentDetails"`
+			Statistics struct {
+				ViewCount string `json:"viewCount"`
+			} `json:"statistics"`
+			Status struct {
+				PrivacyStatus string `json:"privacyStatus"`
+				UploadStatus  string `json:"uploadStatus"`
+			} `json:"status"`
+		} `json:"items"`
+	}
+
+	params := url.Values{
+		"part":       {"snippet,contentDetails,statistics,status"},
+		"id":         {strings.Join(ids, ",")},
+		"maxResults": {strconv.Itoa(YouTubeMaxResults)},
+	}
+	if err := c.get(ctx, "videos", params, &result); err != nil {
+		return nil, err
+	}
+
+	videos := make([]YouTubeVideo, len(result.Items))
+	for i, item := range result.Items {
+		publishedAt, err := time.Parse(time.RFC3339, item.Snippet.PublishedAt)
+		if err != nil {
+			publishedAt = time.Now()
+		}
+		viewCount, _ := strconv.Atoi(item.Statistics.ViewCount)
+
+		// Best thumbnail
+		thumbnailURL := item.Snippet.Thumbnails.High.URL
+		if item.Snippet.Thumbnails.Maxres.URL != "" {
+			thumbnailURL = item.Snippet.Thumbnails.Maxres.URL
+		}
+
+		videos[i] = YouTubeVideo{
+			ID:           item.ID,
+			Title:        item.Snippet.Title,
+			PublishedAt:  publishedAt,
+			Duration:     item.ContentDetails.Duration,
+			ViewCount:    viewCount,
+			ThumbnailURL: thumbnailURL,
+
+			Description:          item.Snippet.Description,
+			ChannelID:            item.Snippet.ChannelID,
+			ChannelTitle:         item.Snippet.ChannelTitle,
+			DefaultAudioLanguage: item.Snippet.DefaultAudioLanguage,
+			CategoryID:           item.Snippet.CategoryID,
+			HasCaptions:          item.ContentDetails.Caption == "true",
+
+			PrivacyStatus: item.Status.PrivacyStatus,
+			UploadStatus:  item.Status.UploadStatus,
+		}
+	}
+	return videos, nil
+}
+
+// get calls GET {baseURL}/{resource} with the API key and decodes the JSON body into out
+func (c *YouTubeClient) get(ctx context.Context, resource string, params url.Values, out interface{}) error {
+	params.Set("key", c.apiKey)
+	endpoint := fmt.Sprintf("%s/%s?%s", c.baseURL, resource, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build YouTube request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call YouTube API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("YouTube API %s returned status: %d", resource, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode YouTube %s response: %w", resource, err)
+	}
+	return nil
+}
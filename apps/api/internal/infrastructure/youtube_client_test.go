@@ -0,0 +1,198 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testYouTubeAPIKey = "test-key"
+
+// newFakeYouTube starts a fake YouTube Data API serving the given handlers by resource name
+// Every request must carry the API key
+func newFakeYouTube(t *testing.T, handlers map[string]http.HandlerFunc) *YouTubeClient {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("key"); got != testYouTubeAPIKey {
+			t.Errorf("%s: key = %q, want %q", r.URL.Path, got, testYouTubeAPIKey)
+		}
+		handler, ok := handlers[strings.TrimPrefix(r.URL.Path, "/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return NewYouTubeClientWithConfig(testYouTubeAPIKey, server.URL+"/", server.Client())
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, body interface{}) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		t.Errorf("encode response: %v", err)
+	}
+}
+
+func TestGetChannelUploadsPlaylist(t *testing.T) {
+	client := newFakeYouTube(t, map[string]http.HandlerFunc{
+		"channels": func(w http.ResponseWriter, r *http.Request) {
+			if part := r.URL.Query().Get("part"); part != "contentDetails" {
+				t.Errorf("part = %q, want contentDetails", part)
+			}
+			if r.URL.Query().Get("id") != "UC_known" {
+				writeJSON(t, w, map[string]interface{}{"items": []interface{}{}})
+				return
+			}
+			writeJSON(t, w, map[string]interface{}{
+				"items": []interface{}{map[string]interface{}{
+					"contentDetails": map[string]interface{}{
+						"relatedPlaylists": map[string]interface{}{"uploads": "UU_known"},
+					},
+				}},
+			})
+		},
+	})
+
+	playlistID, err := client.GetChannelUploadsPlaylist(context.Background(), "UC_known")
+	if err != nil {
+		t.Fatalf("GetChannelUploadsPlaylist: %v", err)
+	}
+	if playlistID != "UU_known" {
+		t.Errorf("playlist = %q, want UU_known", playlistID)
+	}
+
+	if _, err := client.GetChannelUploadsPlaylist(context.Background(), "UC_missing"); err == nil {
+		t.Error("expected an error for an unknown channel")
+	}
+}
+
+func TestGetPlaylistPagePaging(t *testing.T) {
+	pages := map[string]map[string]interface{}{
+		"": {
+			"nextPageToken": "page-2",
+			"pageInfo":      map[string]interface{}{"totalResults": 3},
+			"items": []interface{}{
+				map[string]interface{}{"contentDetails": map[string]interface{}{"videoId": "video000001"}},
+				map[string]interface{}{"contentDetails": map[string]interface{}{"videoId": "video000002"}},
+			},
+		},
+		"page-2": {
+			"pageInfo": map[string]interface{}{"totalResults": 3},
+			"items": []interface{}{
+				map[string]interface{}{"contentDetails": map[string]interface{}{"videoId": "video000003"}},
+				map[string]interface{}{"contentDetails": map[string]interface{}{}}, // Removed video: no ID
+			},
+		},
+	}
+
+	var requestedTokens []string
+	client := newFakeYouTube(t, map[string]http.HandlerFunc{
+		"playlistItems": func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			if query.Get("playlistId") != "UU_known" {
+				t.Errorf("playlistId = %q, want UU_known", query.Get("playlistId"))
+			}
+			if query.Get("maxResults") != "50" {
+				t.Errorf("maxResults = %q, want 50", query.Get("maxResults"))
+			}
+			token := query.Get("pageToken")
+			requestedTokens = append(requestedTokens, token)
+			page, ok := pages[token]
+			if !ok {
+				http.Error(w, "bad page token", http.StatusBadRequest)
+				return
+			}
+			writeJSON(t, w, page)
+		},
+	})
+
+	var ids []string
+	pageToken := ""
+	for range 10 {
+		page, err := client.GetPlaylistPage(context.Background(), "UU_known", pageToken)
+		if err != nil {
+			t.Fatalf("GetPlaylistPage(%q): %v", pageToken, err)
+		}
+		if page.TotalResults != 3 {
+			t.Errorf("TotalResults = %d, want 3", page.TotalResults)
+		}
+		ids = append(ids, page.VideoIDs...)
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+
+	if want := []string{"video000001", "video000002", "video000003"}; !slices.Equal(ids, want) {
+		t.Errorf("video IDs = %v, want %v", ids, want)
+	}
+	if want := []string{"", "page-2"}; !slices.Equal(requestedTokens, want) {
+		t.Errorf("requested page tokens = %v, want %v", requestedTokens, want)
+	}
+
+	if _, err := client.GetPlaylistPage(context.Background(), "UU_known", "expired"); err == nil {
+		t.Error("expected an error for a non-200 response")
+	}
+}
+
+func TestGetVideos(t *testing.T) {
+	client := newFakeYouTube(t, map[string]http.HandlerFunc{
+		"videos": func(w http.ResponseWriter, r *http.Request) {
+			if ids := r.URL.Query().Get("id"); ids != "video000001,video000002" {
+				t.Errorf("id = %q, want video000001,video000002", ids)
+			}
+			writeJSON(t, w, map[string]interface{}{
+				"items": []interface{}{map[string]interface{}{
+					"id": "video000001",
+					"snippet": map[string]interface{}{
+						"title":        "First",
+						"channelId":    "UC_known",
+						"channelTitle": "Known",
+						"publishedAt":  "2024-05-01T10:00:00Z",
+						"thumbnails": map[string]interface{}{
+							"high":   map[string]interface{}{"url": "https://img/high.jpg"},
+							"maxres": map[string]interface{}{"url": "https://img/maxres.jpg"},
+						},
+					},
+					"contentDetails": map[string]interface{}{"duration": "PT1M5S", "caption": "true"},
+					"statistics":     map[string]interface{}{"viewCount": "1234"},
+					"status":         map[string]interface{}{"privacyStatus": "public", "uploadStatus": "processed"},
+				}},
+			})
+		},
+	})
+
+	videos, err := client.GetVideos(context.Background(), []string{"video000001", "video000002"})
+	if err != nil {
+		t.Fatalf("GetVideos: %v", err)
+	}
+	if len(videos) != 1 {
+		t.Fatalf("got %d videos, want 1 (unknown IDs are absent)", len(videos))
+	}
+	v := videos[0]
+	if v.ID != "video000001" || v.Title != "First" || v.ChannelID != "UC_known" {
+		t.Errorf("unexpected video: %+v", v)
+	}
+	if v.ViewCount != 1234 || v.Duration != "PT1M5S" || !v.HasCaptions {
+		t.Errorf("unexpected details: views=%d duration=%q captions=%v", v.ViewCount, v.Duration, v.HasCaptions)
+	}
+	if v.ThumbnailURL != "https://img/maxres.jpg" {
+		t.Errorf("ThumbnailURL = %q, want the maxres thumbnail", v.ThumbnailURL)
+	}
+	if !v.Available() {
+		t.Error("public processed video should be available")
+	}
+
+	tooMany := make([]string, YouTubeMaxResults+1)
+	if _, err := client.GetVideos(context.Background(), tooMany); err == nil {
+		t.Errorf("expected an error for more than %d IDs", YouTubeMaxResults)
+	}
+}
@@ -0,0 +1,62 @@
+package job
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Func is a unit of background work
+type Func func(ctx context.Context) error
+
+// IntervalFromEnv reads a job interval (Go duration, e.g. "6h") from an env var
+// Returns fallback when unset or invalid; "0" or "off" disables the job (returns 0)
+func IntervalFromEnv(key string, fallback time.Duration) time.Duration {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return fallback
+	}
+	if raw == "0" || raw == "off" {
+		return 0
+	}
+
+	interval, err := time.ParseDuration(raw)
+	if err != nil || interval < 0 {
+		log.Warn().Str("env", key).Str("value", raw).Msg("Invalid job interval - using default")
+		return fallback
+	}
+	return interval
+}
+
+// RunPeriodic runs fn every interval until ctx is cancelled
+// The first run starts after one interval so server startup stays fast.
+// Errors are logged and never stop the loop. A zero interval disables the job.
+func RunPeriodic(ctx context.Context, name string, interval time.Duration, fn Func) {
+	if interval <= 0 {
+		log.Info().Str("job", name).Msg("Background job disabled")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		log.Info().Str("job", name).Dur("interval", interval).Msg("Background job scheduled")
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Str("job", name).Msg("Background job stopped")
+				return
+			case <-ticker.C:
+				started := time.Now()
+				if err := fn(ctx); err != nil {
+					log.Error().Err(err).Str("job", name).Msg("Background job failed")
+					continue
+				}
+				log.Info().Str("job", name).Dur("took", time.Since(started)).Msg("Background job finished")
+			}
+		}
+	}()
+}
@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"api/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Locale stores the preferred locales of the request (lang query, then Accept-Language)
+// Services read them with domain.LocalesFromContext to localize tag names
+func Locale() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		locales := domain.ParseLocales(c.Query("lang"), c.GetHeader("Accept-Language"))
+		if len(locales) > 0 {
+			c.Request = c.Request.WithContext(domain.WithLocales(c.Request.Context(), locales))
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ============================================================
+// Alias Management Implementation
+// ============================================================
+
+// GetCanonicalBySlug retrieves canonical tag by slug
+// Returns nil if not found (not an error)
+func (r *tagRepository) GetCanonicalBySlug(ctx context.Context, slug string) (*domain.CanonicalTag, error) {
+	var canonical domain.CanonicalTag
+	err := r.db.WithContext(ctx).
+		Where("slug = ?", slug).
+		First(&canonical).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query canonical by slug: %w", err)
+	}
+
+	return &canonical, nil
+}
+
+// ListAliasesByCanonicalID returns all aliases of a canonical tag
+// Embeddings are omitted to keep the payload small
+func (r *tagRepository) ListAliasesByCanonicalID(ctx context.Context, canonicalID uuid.UUID) ([]domain.TagAlias, error) {
+	var aliases []domain.TagAlias
+	err := r.db.WithContext(ctx).
+		Omit("Embedding").
+		Where("canonical_tag_id = ?", canonicalID).
+		Order("similarity_score ASC, created_at ASC").
+		Find(&aliases).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list aliases: %w", err)
+	}
+
+	return aliases, nil
+}
+
+// GetAliasByID retrieves an alias by ID (embedding omitted)
+func (r *tagRepository) GetAliasByID(ctx context.Context, aliasID uuid.UUID) (*domain.TagAlias, error) {
+	var alias domain.TagAlias
+	err := r.db.WithContext(ctx).
+		Omit("Embedding").
+		Where("id = ?", aliasID).
+		First(&alias).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrAliasNotFound
+		}
+		return nil, fmt.Errorf("failed to get alias: %w", err)
+	}
+
+	return &alias, nil
+}
+
+// lockAliasForDetach loads an alias and locks its canonical tag row, then verifies
+// the canonical keeps at least one alias after the alias is detached.
+// Locking the canonical serializes concurrent detach operations on the same tag.
+func lockAliasForDetach(tx *gorm.DB, aliasID uuid.UUID) (*domain.TagAlias, error) {
+	var alias domain.TagAlias
+	if err := tx.Omit("Embedding").Where("id = ?", aliasID).First(&alias).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrAliasNotFound
+		}
+		return nil, fmt.Errorf("failed to get alias: %w", err)
+	}
+
+	var canonical domain.CanonicalTag
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", alias.CanonicalTagID).
+		First(&canonical).Error; err != nil {
+		return nil, fmt.Errorf("failed to lock canonical tag: %w", err)
+	}
+
+	var count int64
+	if err := tx.Model(&domain.TagAlias{}).
+		Where("canonical_tag_id = ?", alias.CanonicalTagID).
+		Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to count aliases: %w", err)
+	}
+	if count <= 1 {
+		return nil, domain.ErrLastAlias
+	}
+
+	return &alias, nil
+}
+
+// MoveAlias re-points an alias to another canonical tag and marks it reviewed
+func (r *tagRepository) MoveAlias(ctx context.Context, aliasID, targetID uuid.UUID, review *domain.TagAliasReviewLog) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		alias, err := lockAliasForDetach(tx, aliasID)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(&domain.TagAlias{}).
+			Where("id = ?", aliasID).
+			Updates(map[string]interface{}{
+				"canonical_tag_id": targetID,
+				"is_reviewed":      true,
+			}).Error; err != nil {
+			return fmt.Errorf("failed to move alias: %w", err)
+		}
+		if err := dropAliasDisplayNames(tx, aliasID); err != nil {
+			return err
+		}
+
+		if err := adjustTagCounts(tx, alias.CanonicalTagID, 0, -1); err != nil {
+			return err
+		}
+		if err := adjustTagCounts(tx, targetID, 0, 1); err != nil {
+			return err
+		}
+		if err := refreshCentroids(tx, alias.CanonicalTagID, targetID); err != nil {
+			return err
+		}
+
+		if review != nil {
+			review.AliasID = aliasID
+			review.FromCanonicalID = alias.CanonicalTagID
+			review.ToCanonicalID = targetID
+			return recordAliasReview(tx, review)
+		}
+
+		return nil
+	})
+}
+
+// SplitAlias creates a new canonical tag and moves the alias onto it (atomic transaction)
+func (r *tagRepository) SplitAlias(ctx context.Context, aliasID uuid.UUID, canonical *domain.CanonicalTag, review *domain.TagAliasReviewLog) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		alias, err := lockAliasForDetach(tx, aliasID)
+		if err != nil {
+			return err
+		}
+
+		// Pick a free slug under the same lock as CreateCanonicalTag
+		if err := lockTagKeys(tx, "slug:"+canonical.Slug); err != nil {
+			return err
+		}
+		candidates, err := domain.SlugCandidates(canonical.DisplayName, domain.MaxSlugSuffix)
+		if err != nil {
+			return err
+		}
+		slug, err := firstFreeSlug(tx, candidates, uuid.Nil)
+		if err != nil {
+			return err
+		}
+		if slug == "" {
+			return fmt.Errorf("%w: '%s' and its %d variants", domain.ErrSlugTaken, candidates[0], len(candidates)-1)
+		}
+		canonical.Slug = slug
+
+		canonical.AliasCount = 1
+		if err := tx.Create(canonical).Error; err != nil {
+			return fmt.Errorf("failed to create canonical tag: %w", err)
+		}
+
+		// Alias becomes the initial alias of the new canonical → exact match score
+		if err := tx.Model(&domain.TagAlias{}).
+			Where("id = ?", aliasID).
+			Updates(map[string]interface{}{
+				"canonical_tag_id": canonical.ID,
+				"is_reviewed":      true,
+				"similarity_score": 1.0,
+			}).Error; err != nil {
+			return fmt.Errorf("failed to move alias: %w", err)
+		}
+		if err := dropAliasDisplayNames(tx, aliasID); err != nil {
+			return err
+		}
+
+		if err := adjustTagCounts(tx, alias.CanonicalTagID, 0, -1); err != nil {
+			return err
+		}
+		if err := refreshCentroids(tx, alias.CanonicalTagID, canonical.ID); err != nil {
+			return err
+		}
+
+		if review != nil {
+			review.AliasID = aliasID
+			review.FromCanonicalID = alias.CanonicalTagID
+			review.ToCanonicalID = canonical.ID
+			return recordAliasReview(tx, review)
+		}
+
+		return nil
+	})
+}
+
+// DeleteAlias removes an alias, refusing to delete the last one of a canonical
+func (r *tagRepository) DeleteAlias(ctx context.Context, aliasID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		alias, err := lockAliasForDetach(tx, aliasID)
+		if err != nil {
+			return err
+		}
+
+		if err := dropAliasDisplayNames(tx, aliasID); err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", aliasID).Delete(&domain.TagAlias{}).Error; err != nil {
+			return fmt.Errorf("failed to delete alias: %w", err)
+		}
+
+		if err := refreshCentroids(tx, alias.CanonicalTagID); err != nil {
+			return err
+		}
+		return adjustTagCounts(tx, alias.CanonicalTagID, 0, -1)
+	})
+}
+
+// ReopenAliasReview marks an alias unreviewed and clears its reviewer info (back in the review queue)
+// Marking an alias reviewed goes through AcceptAlias so the review log stays complete
+func (r *tagRepository) ReopenAliasReview(ctx context.Context, aliasID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Model(&domain.TagAlias{}).
+		Where("id = ?", aliasID).
+		Updates(map[string]interface{}{
+			"is_reviewed": false,
+			"reviewed_by": nil,
+			"reviewed_at": nil,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to reopen alias review: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrAliasNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+	"gorm.io/gorm"
+)
+
+// ============================================================
+// Canonical Tag Centroid Implementation
+// ============================================================
+
+// nearestCandidatePool is how many index hits are fetched per requested canonical
+// Several nearest aliases can belong to the same canonical, so the pool must be larger than the limit
+const nearestCandidatePool = 10
+
+// refreshCentroids recomputes the centroid of the given tags from their alias embeddings
+// Must be called in the same transaction as the alias change it accounts for
+func refreshCentroids(tx *gorm.DB, tagIDs ...uuid.UUID) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	if err := tx.Exec(`
+		UPDATE canonical_tags ct
+		SET centroid = (
+			SELECT AVG(ta.embedding) FROM tag_aliases ta
+			WHERE ta.canonical_tag_id = ct.id AND ta.embedding IS NOT NULL
+		)
+		WHERE ct.id IN ?
+	`, tagIDs).Error; err != nil {
+		return fmt.Errorf("failed to refresh tag centroids: %w", err)
+	}
+	return nil
+}
+
+// refreshAliasCentroids recomputes the centroids of the tags owning the given aliases
+func refreshAliasCentroids(tx *gorm.DB, aliasIDs []uuid.UUID) error {
+	if len(aliasIDs) == 0 {
+		return nil
+	}
+
+	var tagIDs []uuid.UUID
+	if err := tx.Model(&domain.TagAlias{}).
+		Where("id IN ?", aliasIDs).
+		Distinct().
+		Pluck("canonical_tag_id", &tagIDs).Error; err != nil {
+		return fmt.Errorf("failed to find alias tags: %w", err)
+	}
+	return refreshCentroids(tx, tagIDs...)
+}
+
+// GetNearestCanonicals returns the top-K canonical tags closest to the embedding under strategy
+// Candidates come from the HNSW indexes (aliases and/or centroids), so results are approximate like any HNSW search
+func (r *tagRepository) GetNearestCanonicals(ctx context.Context, embedding pgvector.Vector, strategy domain.MatchStrategy, limit int) ([]domain.CanonicalMatch, error) {
+	if r.openAIClient == nil {
+		return []domain.CanonicalMatch{}, nil // No OpenAI = no semantic search
+	}
+
+	var sqlQuery string
+	args := []interface{}{embedding, limit, limit * nearestCandidatePool}
+	switch strategy {
+	case domain.MatchStrategyCentroid:
+		sqlQuery = `
+			SELECT
+				id AS canonical_tag_id,
+				display_name AS canonical_name,
+				centroid <=> $1::vector AS distance
+			FROM canonical_tags
+			WHERE centroid IS NOT NULL
+			ORDER BY centroid <=> $1::vector ASC
+			LIMIT $2
+		`
+		args = args[:2] // No candidate pool
+	case domain.MatchStrategyHybrid:
+		// Candidates from both indexes, then both distances computed exactly for each candidate
+		sqlQuery = `
+			WITH candidates AS (
+				(SELECT canonical_tag_id AS id FROM tag_aliases
+					WHERE embedding IS NOT NULL
+					ORDER BY embedding <=> $1::vector ASC LIMIT $3)
+				UNION
+				(SELECT id FROM canonical_tags
+					WHERE centroid IS NOT NULL
+					ORDER BY centroid <=> $1::vector ASC LIMIT $3)
+			)
+			SELECT
+				ct.id AS canonical_tag_id,
+				ct.display_name AS canonical_name,
+				((SELECT MIN(ta.embedding <=> $1::vector) FROM tag_aliases ta
+					WHERE ta.canonical_tag_id = ct.id AND ta.embedding IS NOT NULL)
+					+ (ct.centroid <=> $1::vector)) / 2 AS distance
+			FROM candidates c
+			JOIN canonical_tags ct ON ct.id = c.id
+			WHERE ct.centroid IS NOT NULL
+			ORDER BY distance ASC
+			LIMIT $2
+		`
+	default:
+		sqlQuery = `
+			WITH alias_hits AS (
+				SELECT canonical_tag_id, embedding <=> $1::vector AS distance
+				FROM tag_aliases
+				WHERE embedding IS NOT NULL
+				ORDER BY embedding <=> $1::vector ASC
+				LIMIT $3
+			)
+			SELECT
+				ah.canonical_tag_id,
+				ct.display_name AS canonical_name,
+				MIN(ah.distance) AS distance
+			FROM alias_hits ah
+			JOIN canonical_tags ct ON ct.id = ah.canonical_tag_id
+			GROUP BY ah.canonical_tag_id, ct.display_name
+			ORDER BY distance ASC
+			LIMIT $2
+		`
+	}
+
+	var matches []domain.CanonicalMatch
+	if err := r.db.WithContext(ctx).Raw(sqlQuery, args...).Scan(&matches).Error; err != nil {
+		return nil, fmt.Errorf("nearest canonical search failed: %w", err)
+	}
+	return matches, nil
+}
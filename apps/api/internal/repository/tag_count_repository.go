@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ============================================================
+// Tag Usage Counters & Trending Implementation
+// ============================================================
+
+// adjustTagCounts applies incremental changes to the usage counters of a tag
+// Must be called in the same transaction as the change it accounts for
+func adjustTagCounts(tx *gorm.DB, tagID uuid.UUID, videoDelta, aliasDelta int64) error {
+	if videoDelta == 0 && aliasDelta == 0 {
+		return nil
+	}
+
+	if err := tx.Exec(`
+		UPDATE canonical_tags
+		SET video_count = GREATEST(video_count + ?, 0),
+			alias_count = GREATEST(alias_count + ?, 0)
+		WHERE id = ?
+	`, videoDelta, aliasDelta, tagID).Error; err != nil {
+		return fmt.Errorf("failed to update tag counters: %w", err)
+	}
+	return nil
+}
+
+// adjustVideoTagCounts applies videoDelta to the video counter of every tag linked to a video
+// Used when a video leaves or re-enters the live set (soft delete / restore)
+func adjustVideoTagCounts(tx *gorm.DB, videoID uuid.UUID, videoDelta int64) error {
+	if err := tx.Exec(`
+		UPDATE canonical_tags
+		SET video_count = GREATEST(video_count + ?, 0)
+		WHERE id IN (SELECT canonical_tag_id FROM video_canonical_tags WHERE video_id = ?)
+	`, videoDelta, videoID).Error; err != nil {
+		return fmt.Errorf("failed to update tag counters: %w", err)
+	}
+	return nil
+}
+
+// recountTags recomputes the usage counters of the given tags from scratch
+// Used where tracking deltas would be error-prone (e.g. undoing a merge)
+// Soft-deleted videos are not counted
+func recountTags(tx *gorm.DB, tagIDs ...uuid.UUID) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	if err := tx.Exec(`
+		UPDATE canonical_tags ct
+		SET video_count = (
+				SELECT COUNT(*) FROM video_canonical_tags vct
+				JOIN videos v ON v.id = vct.video_id AND v.deleted_at IS NULL
+				WHERE vct.canonical_tag_id = ct.id
+			),
+			alias_count = (SELECT COUNT(*) FROM tag_aliases ta WHERE ta.canonical_tag_id = ct.id)
+		WHERE ct.id IN ?
+	`, tagIDs).Error; err != nil {
+		return fmt.Errorf("failed to recount tag counters: %w", err)
+	}
+	return nil
+}
+
+// GetTrendingTags returns the tags with the most videos tagged since the given time
+// Soft-deleted videos are not counted
+func (r *tagRepository) GetTrendingTags(ctx context.Context, since time.Time, limit int, approvedOnly bool) ([]domain.TrendingTag, error) {
+	var rows []struct {
+		TagID            uuid.UUID
+		RecentVideoCount int64
+	}
+
+	query := r.db.WithContext(ctx).
+		Table("canonical_tags").
+		Select("canonical_tags.id AS tag_id, COUNT(*) AS recent_video_count").
+		Joins("JOIN video_canonical_tags vct ON vct.canonical_tag_id = canonical_tags.id").
+		Joins("JOIN videos v ON v.id = vct.video_id AND v.deleted_at IS NULL").
+		Where("vct.created_at >= ?", since)
+	if approvedOnly {
+		query = query.Where("canonical_tags.is_approved = ?", true)
+	}
+
+	if err := query.
+		Group("canonical_tags.id").
+		Order("recent_video_count DESC, MAX(canonical_tags.video_count) DESC, MAX(canonical_tags.display_name) ASC").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get trending tags: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return []domain.TrendingTag{}, nil
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.TagID
+	}
+
+	var tags []domain.CanonicalTag
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&tags).Error; err != nil {
+		return nil, fmt.Errorf("failed to load trending tags: %w", err)
+	}
+	tagsByID := make(map[uuid.UUID]domain.CanonicalTag, len(tags))
+	for _, t := range tags {
+		tagsByID[t.ID] = t
+	}
+
+	trending := make([]domain.TrendingTag, 0, len(rows))
+	for _, row := range rows {
+		tag, ok := tagsByID[row.TagID]
+		if !ok {
+			continue // Deleted between the two queries
+		}
+		trending = append(trending, domain.TrendingTag{Tag: tag, RecentVideoCount: row.RecentVideoCount})
+	}
+
+	return trending, nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ============================================================
+// Localized Display Names Implementation
+// ============================================================
+
+// GetTagDisplayNames returns the localized names of tags: tagID → locale → name
+func (r *tagRepository) GetTagDisplayNames(ctx context.Context, tagIDs []uuid.UUID) (map[uuid.UUID]map[string]string, error) {
+	return loadTagDisplayNames(r.db.WithContext(ctx), tagIDs)
+}
+
+// SetTagDisplayName creates or replaces the name of a tag for one locale
+func (r *tagRepository) SetTagDisplayName(ctx context.Context, name *domain.TagDisplayName) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "canonical_tag_id"}, {Name: "locale"}},
+		DoUpdates: clause.AssignmentColumns([]string{"display_name", "alias_id", "updated_at"}),
+	}).Create(name).Error
+	if err != nil {
+		return fmt.Errorf("failed to set display name: %w", err)
+	}
+	return nil
+}
+
+// DeleteTagDisplayName removes the name of a tag for one locale
+func (r *tagRepository) DeleteTagDisplayName(ctx context.Context, tagID uuid.UUID, locale string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("canonical_tag_id = ? AND locale = ?", tagID, locale).
+		Delete(&domain.TagDisplayName{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete display name: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetTagDisplayNames returns the localized names of the tags of videos: tagID → locale → name
+func (r *videoRepository) GetTagDisplayNames(tagIDs []uuid.UUID) (map[uuid.UUID]map[string]string, error) {
+	return loadTagDisplayNames(r.db, tagIDs)
+}
+
+// loadTagDisplayNames loads every localized name of tagIDs in one query
+// Shared by the tag and video repositories
+func loadTagDisplayNames(db *gorm.DB, tagIDs []uuid.UUID) (map[uuid.UUID]map[string]string, error) {
+	names := make(map[uuid.UUID]map[string]string)
+	if len(tagIDs) == 0 {
+		return names, nil
+	}
+
+	var rows []domain.TagDisplayName
+	if err := db.Where("canonical_tag_id IN ?", tagIDs).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load display names: %w", err)
+	}
+
+	for _, row := range rows {
+		if names[row.CanonicalTagID] == nil {
+			names[row.CanonicalTagID] = make(map[string]string)
+		}
+		names[row.CanonicalTagID][row.Locale] = row.DisplayName
+	}
+	return names, nil
+}
+
+// dropAliasDisplayNames removes the localized names promoted from an alias
+// Called when the alias leaves its canonical (moved, split off or deleted)
+func dropAliasDisplayNames(tx *gorm.DB, aliasID uuid.UUID) error {
+	if err := tx.Where("alias_id = ?", aliasID).Delete(&domain.TagDisplayName{}).Error; err != nil {
+		return fmt.Errorf("failed to delete display names of alias: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// duplicateNeighborsPerAlias is how many nearest foreign aliases are inspected per alias
+const duplicateNeighborsPerAlias = 5
+
+// ============================================================
+// Duplicate Detection Implementation
+// ============================================================
+
+// FindDuplicatePairs returns canonical pairs whose closest aliases are within maxDistance
+// or whose display names have trigram similarity >= minTrigram
+// Pairs are normalized so that tag_a_id < tag_b_id
+func (r *tagRepository) FindDuplicatePairs(ctx context.Context, maxDistance, minTrigram float64) ([]domain.DuplicatePair, error) {
+	var pairs []domain.DuplicatePair
+
+	// nearest: for each alias, its K nearest aliases on OTHER canonicals (HNSW index)
+	// emb:     closest alias pair per canonical pair
+	// trgm:    canonical pairs with similar display names (pg_trgm % operator → GIN trigram index)
+	sqlQuery := `
+		WITH nearest AS (
+			SELECT
+				LEAST(a.canonical_tag_id, nb.canonical_tag_id) AS tag_a_id,
+				GREATEST(a.canonical_tag_id, nb.canonical_tag_id) AS tag_b_id,
+				CASE WHEN a.canonical_tag_id < nb.canonical_tag_id THEN a.raw_text ELSE nb.raw_text END AS alias_a,
+				CASE WHEN a.canonical_tag_id < nb.canonical_tag_id THEN nb.raw_text ELSE a.raw_text END AS alias_b,
+				nb.distance
+			FROM tag_aliases a
+			CROSS JOIN LATERAL (
+				SELECT b.canonical_tag_id, b.raw_text, b.embedding <=> a.embedding AS distance
+				FROM tag_aliases b
+				WHERE b.canonical_tag_id <> a.canonical_tag_id
+					AND b.embedding IS NOT NULL
+				ORDER BY b.embedding <=> a.embedding
+				LIMIT ?
+			) nb
+			WHERE a.embedding IS NOT NULL
+				AND nb.distance < ?
+		),
+		emb AS (
+			SELECT DISTINCT ON (tag_a_id, tag_b_id) tag_a_id, tag_b_id, alias_a, alias_b, distance
+			FROM nearest
+			ORDER BY tag_a_id, tag_b_id, distance ASC
+		),
+		trgm AS (
+			SELECT a.id AS tag_a_id, b.id AS tag_b_id
+			FROM canonical_tags a
+			JOIN canonical_tags b ON a.display_name % b.display_name AND a.id < b.id
+		),
+		pairs AS (
+			SELECT tag_a_id, tag_b_id FROM emb
+			UNION
+			SELECT tag_a_id, tag_b_id FROM trgm
+		)
+		SELECT
+			p.tag_a_id,
+			p.tag_b_id,
+			ca.slug AS slug_a,
+			cb.slug AS slug_b,
+			COALESCE(emb.alias_a, ca.display_name) AS alias_a,
+			COALESCE(emb.alias_b, cb.display_name) AS alias_b,
+			emb.distance AS embedding_distance,
+			similarity(ca.display_name, cb.display_name) AS trigram_similarity,
+			(
+				SELECT COUNT(*)
+				FROM video_canonical_tags va
+				JOIN video_canonical_tags vb ON vb.video_id = va.video_id AND vb.canonical_tag_id = p.tag_b_id
+				WHERE va.canonical_tag_id = p.tag_a_id
+			) AS co_occurrence_count
+		FROM pairs p
+		JOIN canonical_tags ca ON ca.id = p.tag_a_id
+		JOIN canonical_tags cb ON cb.id = p.tag_b_id
+		LEFT JOIN emb ON emb.tag_a_id = p.tag_a_id AND emb.tag_b_id = p.tag_b_id
+	`
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// % compares against pg_trgm.similarity_threshold; set_config(..., true) = SET LOCAL with a bind parameter
+		if err := tx.Exec("SELECT set_config('pg_trgm.similarity_threshold', ?, true)", strconv.FormatFloat(minTrigram, 'f', -1, 64)).Error; err != nil {
+			return fmt.Errorf("failed to set trigram threshold: %w", err)
+		}
+
+		if err := tx.Raw(sqlQuery, duplicateNeighborsPerAlias, maxDistance).Scan(&pairs).Error; err != nil {
+			return fmt.Errorf("duplicate pair search failed: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pairs, nil
+}
+
+// SaveDuplicateCandidates upserts pending candidates (resolved pairs are left untouched)
+// and removes pending scan candidates not refreshed since refreshedAt (stale pairs)
+// Candidates suggested by ResolveTag keep their source and are never purged here
+func (r *tagRepository) SaveDuplicateCandidates(ctx context.Context, candidates []domain.TagDuplicateCandidate, refreshedAt time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(candidates) > 0 {
+			// Dismissed/accepted pairs keep their status: the WHERE on DO UPDATE skips them
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "tag_a_id"}, {Name: "tag_b_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"score", "embedding_distance", "slug_similarity", "trigram_similarity",
+					"matched_alias_a", "matched_alias_b", "co_occurrence_count", "updated_at",
+				}),
+				Where: clause.Where{Exprs: []clause.Expression{
+					clause.Expr{SQL: "tag_duplicate_candidates.status = ?", Vars: []interface{}{domain.DuplicateStatusPending}},
+				}},
+			}).CreateInBatches(&candidates, 100).Error; err != nil {
+				return fmt.Errorf("failed to save duplicate candidates: %w", err)
+			}
+		}
+
+		if err := tx.Where("status = ? AND source = ? AND updated_at < ?", domain.DuplicateStatusPending, domain.DuplicateSourceScan, refreshedAt).
+			Delete(&domain.TagDuplicateCandidate{}).Error; err != nil {
+			return fmt.Errorf("failed to remove stale duplicate candidates: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ListDuplicateCandidates returns pending candidates, highest score first
+// Pairs whose tags no longer exist (e.g. merged elsewhere) are excluded
+func (r *tagRepository) ListDuplicateCandidates(ctx context.Context, page, limit int) ([]domain.TagDuplicateCandidate, int64, error) {
+	var candidates []domain.TagDuplicateCandidate
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&domain.TagDuplicateCandidate{}).
+		Joins("JOIN canonical_tags ca ON ca.id = tag_duplicate_candidates.tag_a_id").
+		Joins("JOIN canonical_tags cb ON cb.id = tag_duplicate_candidates.tag_b_id").
+		Where("tag_duplicate_candidates.status = ?", domain.DuplicateStatusPending)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count duplicate candidates: %w", err)
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Select("tag_duplicate_candidates.*").
+		Order("tag_duplicate_candidates.score DESC, tag_duplicate_candidates.id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&candidates).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list duplicate candidates: %w", err)
+	}
+
+	return candidates, total, nil
+}
+
+// GetDuplicateCandidateByID retrieves a duplicate candidate by ID
+func (r *tagRepository) GetDuplicateCandidateByID(ctx context.Context, id uint) (*domain.TagDuplicateCandidate, error) {
+	var candidate domain.TagDuplicateCandidate
+	err := r.db.WithContext(ctx).First(&candidate, "id = ?", id).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrDuplicateNotFound
+		}
+		return nil, fmt.Errorf("failed to get duplicate candidate: %w", err)
+	}
+
+	return &candidate, nil
+}
+
+// ResolveDuplicateCandidate sets the final status of a pending candidate
+// Returns ErrDuplicateResolved if the candidate is no longer pending
+func (r *tagRepository) ResolveDuplicateCandidate(ctx context.Context, id uint, status domain.DuplicateStatus, reviewerID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Model(&domain.TagDuplicateCandidate{}).
+		Where("id = ? AND status = ?", id, domain.DuplicateStatusPending).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"reviewed_by": reviewerID,
+			"reviewed_at": time.Now(),
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to resolve duplicate candidate: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		// Distinguish "missing" from "already resolved"
+		if _, err := r.GetDuplicateCandidateByID(ctx, id); err != nil {
+			return err
+		}
+		return domain.ErrDuplicateResolved
+	}
+
+	return nil
+}
+
+// GetCoOccurringVideos returns, for each duplicate candidate, a sample of recent videos tagged with both tags
+// One query for the whole page (keyed by candidate ID); trashed videos are skipped
+func (r *tagRepository) GetCoOccurringVideos(ctx context.Context, candidateIDs []uint, limit int) (map[uint][]domain.Video, error) {
+	result := make(map[uint][]domain.Video, len(candidateIDs))
+	if len(candidateIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		CandidateID uint
+		ID          uuid.UUID
+		YoutubeID   string
+		Title       string
+	}
+
+	sqlQuery := `
+		SELECT c.id AS candidate_id, v.id, v.youtube_id, v.title
+		FROM tag_duplicate_candidates c
+		CROSS JOIN LATERAL (
+			SELECT videos.id, videos.youtube_id, videos.title, videos.created_at
+			FROM videos
+			JOIN video_canonical_tags va ON va.video_id = videos.id AND va.canonical_tag_id = c.tag_a_id
+			JOIN video_canonical_tags vb ON vb.video_id = videos.id AND vb.canonical_tag_id = c.tag_b_id
+			WHERE videos.deleted_at IS NULL
+			ORDER BY videos.created_at DESC
+			LIMIT ?
+		) v
+		WHERE c.id IN ?
+		ORDER BY c.id, v.created_at DESC
+	`
+
+	if err := r.db.WithContext(ctx).Raw(sqlQuery, limit, candidateIDs).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load co-occurring videos: %w", err)
+	}
+
+	for _, row := range rows {
+		result[row.CandidateID] = append(result[row.CandidateID], domain.Video{
+			ID:        row.ID,
+			YoutubeID: row.YoutubeID,
+			Title:     row.Title,
+		})
+	}
+	return result, nil
+}
+
+// AddDuplicateCandidate stores one pending candidate unless the pair is already known
+// Used by ResolveTag in suggest mode; the next detection scan refreshes its scores
+func (r *tagRepository) AddDuplicateCandidate(ctx context.Context, candidate *domain.TagDuplicateCandidate) error {
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(candidate).Error; err != nil {
+		return fmt.Errorf("failed to add duplicate candidate: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// subtreeTagIDsSQL selects the IDs of a tag and all its descendants (1 arg: root tag ID)
+// UNION (not UNION ALL) stops the recursion even if bad data ever contains a cycle
+const subtreeTagIDsSQL = `
+	WITH RECURSIVE subtree AS (
+		SELECT id FROM canonical_tags WHERE id = ?
+		UNION
+		SELECT c.id FROM canonical_tags c JOIN subtree s ON c.parent_id = s.id
+	)
+	SELECT id FROM subtree
+`
+
+// hierarchyLockKey serializes every change to parent_id (see lockTagKeys)
+// Row locks are not enough: A→B, B→C and C→A touch different rows but together form a cycle
+const hierarchyLockKey = "tag-hierarchy"
+
+// ============================================================
+// Tag Hierarchy Implementation
+// ============================================================
+
+// isInSubtree reports whether nodeID is rootID itself or one of its descendants
+func isInSubtree(tx *gorm.DB, rootID, nodeID uuid.UUID) (bool, error) {
+	var found bool
+	err := tx.Raw("SELECT EXISTS (SELECT 1 FROM ("+subtreeTagIDsSQL+") t WHERE t.id = ?)", rootID, nodeID).
+		Scan(&found).Error
+
+	if err != nil {
+		return false, fmt.Errorf("failed to walk tag subtree: %w", err)
+	}
+
+	return found, nil
+}
+
+// SetCanonicalParent moves a tag (and its whole subtree) under parentID, or to the root if nil
+// Returns ErrTagCycle if parentID is the tag itself or one of its descendants
+func (r *tagRepository) SetCanonicalParent(ctx context.Context, tagID uuid.UUID, parentID *uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// One move at a time: the cycle check below must see every other committed move
+		if err := lockTagKeys(tx, hierarchyLockKey); err != nil {
+			return err
+		}
+
+		var tag domain.CanonicalTag
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&tag, "id = ?", tagID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrCanonicalTagNotFound
+			}
+			return fmt.Errorf("failed to lock tag: %w", err)
+		}
+
+		if parentID != nil {
+			var parent domain.CanonicalTag
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				First(&parent, "id = ?", *parentID).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return domain.ErrParentTagNotFound
+				}
+				return fmt.Errorf("failed to lock parent tag: %w", err)
+			}
+
+			cycle, err := isInSubtree(tx, tagID, *parentID)
+			if err != nil {
+				return err
+			}
+			if cycle {
+				return domain.ErrTagCycle
+			}
+		}
+
+		if err := tx.Model(&tag).Update("parent_id", parentID).Error; err != nil {
+			return fmt.Errorf("failed to update parent: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetTagTreeNodes returns every canonical tag with its parent and video counts
+// TotalVideoCount counts distinct videos across the node's whole subtree (soft-deleted videos excluded)
+func (r *tagRepository) GetTagTreeNodes(ctx context.Context) ([]domain.TagTreeNode, error) {
+	var nodes []domain.TagTreeNode
+
+	sqlQuery := `
+		WITH RECURSIVE closure AS (
+			SELECT id AS ancestor_id, id AS descendant_id FROM canonical_tags
+			UNION
+			SELECT cl.ancestor_id, c.id
+			FROM closure cl
+			JOIN canonical_tags c ON c.parent_id = cl.descendant_id
+		),
+		totals AS (
+			SELECT cl.ancestor_id, COUNT(DISTINCT vct.video_id) AS total_video_count
+			FROM closure cl
+			LEFT JOIN (
+				video_canonical_tags vct
+				JOIN videos v ON v.id = vct.video_id AND v.deleted_at IS NULL
+			) ON vct.canonical_tag_id = cl.descendant_id
+			GROUP BY cl.ancestor_id
+		),
+		direct AS (
+			SELECT vct.canonical_tag_id, COUNT(*) AS video_count
+			FROM video_canonical_tags vct
+			JOIN videos v ON v.id = vct.video_id AND v.deleted_at IS NULL
+			GROUP BY vct.canonical_tag_id
+		)
+		SELECT
+			ct.id,
+			ct.parent_id,
+			ct.slug,
+			ct.display_name,
+			ct.is_approved,
+			COALESCE(direct.video_count, 0) AS video_count,
+			COALESCE(totals.total_video_count, 0) AS total_video_count
+		FROM canonical_tags ct
+		LEFT JOIN direct ON direct.canonical_tag_id = ct.id
+		LEFT JOIN totals ON totals.ancestor_id = ct.id
+		ORDER BY ct.display_name ASC
+	`
+
+	if err := r.db.WithContext(ctx).Raw(sqlQuery).Scan(&nodes).Error; err != nil {
+		return nil, fmt.Errorf("failed to load tag tree: %w", err)
+	}
+
+	return nodes, nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// ============================================================
+// Concurrent Resolution Helpers
+// ============================================================
+
+// lockTagKeys takes transaction-scoped advisory locks on keys (e.g. "alias:học máy", "slug:hoc-may")
+// Concurrent resolutions of the same text wait here instead of racing on the unique indexes
+// Keys are locked in sorted order so two transactions can never deadlock on each other
+// The locks are held only by the write transaction: work done before it (translation,
+// embedding) is not serialized, so concurrent resolutions may repeat it and the loser discards it
+func lockTagKeys(tx *gorm.DB, keys ...string) error {
+	sorted := append([]string(nil), keys...)
+	sort.Strings(sorted)
+
+	for _, key := range sorted {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", key).Error; err != nil {
+			return fmt.Errorf("failed to lock '%s': %w", key, err)
+		}
+	}
+	return nil
+}
+
+// aliasTextTaken reports whether an alias with this normalized text already exists
+func aliasTextTaken(tx *gorm.DB, normalizedText string) (bool, error) {
+	var count int64
+	if err := tx.Model(&domain.TagAlias{}).Where("normalized_text = ?", normalizedText).Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check alias text: %w", err)
+	}
+	return count > 0, nil
+}
+
+// insertAlias inserts an alias; an empty embedding is stored as NULL (pgvector rejects "[]")
+// A unique violation on the alias text is reported as domain.ErrAliasTaken
+func insertAlias(tx *gorm.DB, alias *domain.TagAlias) error {
+	query := tx
+	if len(alias.Embedding.Slice()) == 0 {
+		query = tx.Omit("Embedding")
+	}
+
+	if err := query.Create(alias).Error; err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%w: '%s'", domain.ErrAliasTaken, alias.NormalizedText)
+		}
+		return err
+	}
+	return nil
+}
+
+// firstFreeSlug returns the first candidate not used by another tag, now or in the past
+// Returns "" if every candidate is taken
+func firstFreeSlug(tx *gorm.DB, candidates []string, tagID uuid.UUID) (string, error) {
+	var taken []string
+	if err := tx.Raw(`
+		SELECT slug FROM canonical_tags WHERE slug IN ? AND id <> ?
+		UNION
+		SELECT slug FROM tag_slug_history WHERE slug IN ? AND canonical_tag_id <> ?
+	`, candidates, tagID, candidates, tagID).Scan(&taken).Error; err != nil {
+		return "", fmt.Errorf("failed to check slug candidates: %w", err)
+	}
+
+	takenSet := make(map[string]bool, len(taken))
+	for _, s := range taken {
+		takenSet[s] = true
+	}
+	for _, candidate := range candidates {
+		if !takenSet[candidate] {
+			return candidate, nil
+		}
+	}
+	return "", nil
+}
+
+// isUniqueViolation reports a Postgres unique_violation (SQLSTATE 23505)
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -0,0 +1,456 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ============================================================
+// Tag Merge Log / Undo Implementation
+// ============================================================
+
+// recordMergeLog snapshots the source tag, its aliases and video links
+// Must be called inside the merge transaction, BEFORE aliases and links are moved
+func recordMergeLog(tx *gorm.DB, source *domain.CanonicalTag, targetID uuid.UUID, mergedBy *uuid.UUID) (*domain.TagMergeLog, error) {
+	mergeLog := &domain.TagMergeLog{
+		SourceTagID:       source.ID,
+		TargetTagID:       targetID,
+		SourceSlug:        source.Slug,
+		SourceDisplayName: source.DisplayName,
+		SourceIsApproved:  source.IsApproved,
+		SourceCreatedAt:   source.CreatedAt,
+		SourceUpdatedAt:   source.UpdatedAt,
+		SourceParentID:    source.ParentID,
+		MergedBy:          mergedBy,
+	}
+	if err := tx.Omit(clause.Associations).Create(mergeLog).Error; err != nil {
+		return nil, fmt.Errorf("failed to create merge log: %w", err)
+	}
+
+	if err := tx.Exec(`
+		INSERT INTO tag_merge_log_aliases (merge_log_id, alias_id)
+		SELECT ?, id FROM tag_aliases WHERE canonical_tag_id = ?
+	`, mergeLog.ID, source.ID).Error; err != nil {
+		return nil, fmt.Errorf("failed to record moved aliases: %w", err)
+	}
+
+	// link_created = target did not have this video yet → the merge creates the link
+	if err := tx.Exec(`
+		INSERT INTO tag_merge_log_videos (merge_log_id, video_id, link_created)
+		SELECT ?, s.video_id, NOT EXISTS (
+			SELECT 1 FROM video_canonical_tags t
+			WHERE t.video_id = s.video_id AND t.canonical_tag_id = ?
+		)
+		FROM video_canonical_tags s
+		WHERE s.canonical_tag_id = ?
+	`, mergeLog.ID, targetID, source.ID).Error; err != nil {
+		return nil, fmt.Errorf("failed to record video links: %w", err)
+	}
+
+	if err := tx.Preload("Aliases").Preload("Videos").First(mergeLog, mergeLog.ID).Error; err != nil {
+		return nil, fmt.Errorf("failed to reload merge log: %w", err)
+	}
+
+	return mergeLog, nil
+}
+
+// reparentForMerge keeps the hierarchy valid when source disappears:
+// - if target sits inside source's subtree, it is lifted to source's parent first
+// - source's children are re-parented to target
+// The changes are written to the merge log so UndoMerge can revert them.
+func reparentForMerge(tx *gorm.DB, mergeLog *domain.TagMergeLog, source, target *domain.CanonicalTag) error {
+	if err := lockTagKeys(tx, hierarchyLockKey); err != nil {
+		return err
+	}
+
+	targetInSource, err := isInSubtree(tx, source.ID, target.ID)
+	if err != nil {
+		return err
+	}
+	if targetInSource {
+		mergeLog.TargetMoved = true
+		mergeLog.TargetPrevParentID = target.ParentID
+		if err := tx.Model(target).Update("parent_id", source.ParentID).Error; err != nil {
+			return fmt.Errorf("failed to lift target tag: %w", err)
+		}
+	}
+
+	if err := tx.Exec(`
+		INSERT INTO tag_merge_log_children (merge_log_id, child_id)
+		SELECT ?, id FROM canonical_tags WHERE parent_id = ? AND id <> ?
+	`, mergeLog.ID, source.ID, target.ID).Error; err != nil {
+		return fmt.Errorf("failed to record child tags: %w", err)
+	}
+
+	if err := tx.Model(&domain.CanonicalTag{}).
+		Where("parent_id = ? AND id <> ?", source.ID, target.ID).
+		Update("parent_id", target.ID).Error; err != nil {
+		return fmt.Errorf("failed to re-parent child tags: %w", err)
+	}
+
+	if err := tx.Model(mergeLog).Omit(clause.Associations).Updates(map[string]interface{}{
+		"target_moved":          mergeLog.TargetMoved,
+		"target_prev_parent_id": mergeLog.TargetPrevParentID,
+	}).Error; err != nil {
+		return fmt.Errorf("failed to update merge log: %w", err)
+	}
+
+	return tx.Model(mergeLog).Association("Children").Find(&mergeLog.Children)
+}
+
+// moveSlugsForMerge hands the source's slugs to target so old URLs keep redirecting:
+// its historic slugs are re-pointed to target and its current slug becomes a historic slug of target
+// The moved historic slugs are written to the merge log so UndoMerge can give them back.
+func moveSlugsForMerge(tx *gorm.DB, mergeLog *domain.TagMergeLog) error {
+	if err := tx.Exec(`
+		INSERT INTO tag_merge_log_slugs (merge_log_id, slug)
+		SELECT ?, slug FROM tag_slug_history WHERE canonical_tag_id = ?
+	`, mergeLog.ID, mergeLog.SourceTagID).Error; err != nil {
+		return fmt.Errorf("failed to record slug history: %w", err)
+	}
+
+	if err := tx.Model(&domain.TagSlugHistory{}).
+		Where("canonical_tag_id = ?", mergeLog.SourceTagID).
+		Update("canonical_tag_id", mergeLog.TargetTagID).Error; err != nil {
+		return fmt.Errorf("failed to move slug history: %w", err)
+	}
+
+	if err := tx.Create(&domain.TagSlugHistory{
+		CanonicalTagID: mergeLog.TargetTagID,
+		Slug:           mergeLog.SourceSlug,
+	}).Error; err != nil {
+		return fmt.Errorf("failed to keep source slug: %w", err)
+	}
+
+	return tx.Model(mergeLog).Association("Slugs").Find(&mergeLog.Slugs)
+}
+
+// clearSourceForMerge removes rows that only make sense while source exists:
+// - localized names (snapshotted in the merge log, restored by UndoMerge)
+// - related-tag rows (recomputed by the related tags job)
+// - pending duplicate suggestions; the source/target pair itself is marked accepted
+func clearSourceForMerge(tx *gorm.DB, mergeLog *domain.TagMergeLog) error {
+	sourceID, targetID := mergeLog.SourceTagID, mergeLog.TargetTagID
+
+	if err := tx.Exec(`
+		INSERT INTO tag_merge_log_display_names (merge_log_id, locale, display_name, alias_id)
+		SELECT ?, locale, display_name, alias_id FROM tag_display_names WHERE canonical_tag_id = ?
+	`, mergeLog.ID, sourceID).Error; err != nil {
+		return fmt.Errorf("failed to record display names: %w", err)
+	}
+	if err := tx.Where("canonical_tag_id = ?", sourceID).Delete(&domain.TagDisplayName{}).Error; err != nil {
+		return fmt.Errorf("failed to delete display names: %w", err)
+	}
+
+	if err := tx.Where("tag_id = ? OR related_tag_id = ?", sourceID, sourceID).Delete(&domain.TagRelation{}).Error; err != nil {
+		return fmt.Errorf("failed to delete tag relations: %w", err)
+	}
+
+	if err := tx.Model(&domain.TagDuplicateCandidate{}).
+		Where("status = ?", domain.DuplicateStatusPending).
+		Where("(tag_a_id = ? AND tag_b_id = ?) OR (tag_a_id = ? AND tag_b_id = ?)", sourceID, targetID, targetID, sourceID).
+		Updates(map[string]interface{}{
+			"status":      domain.DuplicateStatusAccepted,
+			"reviewed_by": mergeLog.MergedBy,
+			"reviewed_at": time.Now(),
+		}).Error; err != nil {
+		return fmt.Errorf("failed to accept duplicate candidate: %w", err)
+	}
+	if err := tx.Where("status = ? AND (tag_a_id = ? OR tag_b_id = ?)", domain.DuplicateStatusPending, sourceID, sourceID).
+		Delete(&domain.TagDuplicateCandidate{}).Error; err != nil {
+		return fmt.Errorf("failed to delete duplicate candidates: %w", err)
+	}
+
+	return tx.Model(mergeLog).Association("DisplayNames").Find(&mergeLog.DisplayNames)
+}
+
+// GetMergeLogByID retrieves a merge log with its aliases and videos
+func (r *tagRepository) GetMergeLogByID(ctx context.Context, id uint) (*domain.TagMergeLog, error) {
+	var mergeLog domain.TagMergeLog
+	err := r.db.WithContext(ctx).
+		Preload("Aliases").
+		Preload("Videos").
+		Preload("Children").
+		Preload("Slugs").
+		Preload("DisplayNames").
+		First(&mergeLog, id).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrMergeLogNotFound
+		}
+		return nil, fmt.Errorf("failed to get merge log: %w", err)
+	}
+
+	return &mergeLog, nil
+}
+
+// UndoMerge restores the source tag, its aliases and video links exactly as before the merge
+// Refused (ErrMergeUndoConflict) unless the merged state is still intact:
+// - target tag still exists
+// - source ID and slug are still free (no other tag uses the slug, now or historically)
+// - the source's historic slugs still belong to the target
+// - every moved alias still points to the target
+// - every video of the source is still linked to the target
+// - re-parented children are still under the target (and source's parent still exists)
+func (r *tagRepository) UndoMerge(ctx context.Context, id uint, undoneBy uuid.UUID) (*domain.TagMergeLog, error) {
+	var mergeLog domain.TagMergeLog
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&mergeLog, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrMergeLogNotFound
+			}
+			return fmt.Errorf("failed to lock merge log: %w", err)
+		}
+		if mergeLog.UndoneAt != nil {
+			return domain.ErrMergeAlreadyUndone
+		}
+		// Hierarchy is restored below; no other move may interleave with the checks
+		if err := lockTagKeys(tx, hierarchyLockKey); err != nil {
+			return err
+		}
+		if err := tx.Model(&mergeLog).Association("Aliases").Find(&mergeLog.Aliases); err != nil {
+			return fmt.Errorf("failed to load merge log aliases: %w", err)
+		}
+		if err := tx.Model(&mergeLog).Association("Videos").Find(&mergeLog.Videos); err != nil {
+			return fmt.Errorf("failed to load merge log videos: %w", err)
+		}
+		if err := tx.Model(&mergeLog).Association("Children").Find(&mergeLog.Children); err != nil {
+			return fmt.Errorf("failed to load merge log children: %w", err)
+		}
+		if err := tx.Model(&mergeLog).Association("Slugs").Find(&mergeLog.Slugs); err != nil {
+			return fmt.Errorf("failed to load merge log slugs: %w", err)
+		}
+		if err := tx.Model(&mergeLog).Association("DisplayNames").Find(&mergeLog.DisplayNames); err != nil {
+			return fmt.Errorf("failed to load merge log display names: %w", err)
+		}
+
+		if err := checkMergeIntact(tx, &mergeLog); err != nil {
+			return err
+		}
+
+		// 1. Recreate source canonical with its original identity
+		source := domain.CanonicalTag{
+			ID:          mergeLog.SourceTagID,
+			Slug:        mergeLog.SourceSlug,
+			DisplayName: mergeLog.SourceDisplayName,
+			IsApproved:  mergeLog.SourceIsApproved,
+			ParentID:    mergeLog.SourceParentID,
+			CreatedAt:   mergeLog.SourceCreatedAt,
+			UpdatedAt:   mergeLog.SourceUpdatedAt,
+		}
+		if err := tx.Create(&source).Error; err != nil {
+			return fmt.Errorf("failed to restore source tag: %w", err)
+		}
+
+		// 2. Move aliases back
+		if err := tx.Exec(`
+			UPDATE tag_aliases SET canonical_tag_id = ?
+			WHERE id IN (SELECT alias_id FROM tag_merge_log_aliases WHERE merge_log_id = ?)
+		`, mergeLog.SourceTagID, mergeLog.ID).Error; err != nil {
+			return fmt.Errorf("failed to restore aliases: %w", err)
+		}
+
+		// 3. Drop target links that only exist because of the merge
+		if err := tx.Exec(`
+			DELETE FROM video_canonical_tags
+			WHERE canonical_tag_id = ?
+				AND video_id IN (
+					SELECT video_id FROM tag_merge_log_videos
+					WHERE merge_log_id = ? AND link_created
+				)
+		`, mergeLog.TargetTagID, mergeLog.ID).Error; err != nil {
+			return fmt.Errorf("failed to remove merged video links: %w", err)
+		}
+
+		// 4. Restore source video links
+		if err := tx.Exec(`
+			INSERT INTO video_canonical_tags (video_id, canonical_tag_id)
+			SELECT video_id, ? FROM tag_merge_log_videos WHERE merge_log_id = ?
+			ON CONFLICT (video_id, canonical_tag_id) DO NOTHING
+		`, mergeLog.SourceTagID, mergeLog.ID).Error; err != nil {
+			return fmt.Errorf("failed to restore video links: %w", err)
+		}
+
+		// 5. Restore hierarchy: children back under source, target back to its old parent
+		if err := tx.Exec(`
+			UPDATE canonical_tags SET parent_id = ?
+			WHERE id IN (SELECT child_id FROM tag_merge_log_children WHERE merge_log_id = ?)
+		`, mergeLog.SourceTagID, mergeLog.ID).Error; err != nil {
+			return fmt.Errorf("failed to restore child tags: %w", err)
+		}
+		if mergeLog.TargetMoved {
+			if err := tx.Model(&domain.CanonicalTag{}).
+				Where("id = ?", mergeLog.TargetTagID).
+				Update("parent_id", mergeLog.TargetPrevParentID).Error; err != nil {
+				return fmt.Errorf("failed to restore target parent: %w", err)
+			}
+		}
+
+		// 6. Give the slugs back: current slug leaves target's history, old slugs return to source
+		if err := tx.Where("canonical_tag_id = ? AND slug = ?", mergeLog.TargetTagID, mergeLog.SourceSlug).
+			Delete(&domain.TagSlugHistory{}).Error; err != nil {
+			return fmt.Errorf("failed to release source slug: %w", err)
+		}
+		if err := tx.Exec(`
+			UPDATE tag_slug_history SET canonical_tag_id = ?
+			WHERE canonical_tag_id = ?
+				AND slug IN (SELECT slug FROM tag_merge_log_slugs WHERE merge_log_id = ?)
+		`, mergeLog.SourceTagID, mergeLog.TargetTagID, mergeLog.ID).Error; err != nil {
+			return fmt.Errorf("failed to restore slug history: %w", err)
+		}
+
+		// 7. Restore localized names (related tags are recomputed by the job)
+		if err := tx.Exec(`
+			INSERT INTO tag_display_names (canonical_tag_id, locale, display_name, alias_id, created_at, updated_at)
+			SELECT ?, locale, display_name, alias_id, NOW(), NOW()
+			FROM tag_merge_log_display_names WHERE merge_log_id = ?
+		`, mergeLog.SourceTagID, mergeLog.ID).Error; err != nil {
+			return fmt.Errorf("failed to restore display names: %w", err)
+		}
+
+		// 8. Counters and centroids of both tags changed in several steps → recompute
+		if err := recountTags(tx, mergeLog.SourceTagID, mergeLog.TargetTagID); err != nil {
+			return err
+		}
+		if err := refreshCentroids(tx, mergeLog.SourceTagID, mergeLog.TargetTagID); err != nil {
+			return err
+		}
+
+		// 9. Mark merge as undone
+		now := time.Now()
+		if err := tx.Model(&mergeLog).Updates(map[string]interface{}{
+			"undone_by": undoneBy,
+			"undone_at": now,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to mark merge undone: %w", err)
+		}
+		mergeLog.UndoneBy = &undoneBy
+		mergeLog.UndoneAt = &now
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mergeLog, nil
+}
+
+// checkMergeIntact verifies nothing changed since the merge (see UndoMerge)
+// Locks the target tag row so concurrent merges/splits on it wait for the undo
+func checkMergeIntact(tx *gorm.DB, mergeLog *domain.TagMergeLog) error {
+	var target domain.CanonicalTag
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&target, "id = ?", mergeLog.TargetTagID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: target tag no longer exists", domain.ErrMergeUndoConflict)
+		}
+		return fmt.Errorf("failed to lock target tag: %w", err)
+	}
+
+	var taken int64
+	if err := tx.Model(&domain.CanonicalTag{}).
+		Where("id = ? OR slug = ?", mergeLog.SourceTagID, mergeLog.SourceSlug).
+		Count(&taken).Error; err != nil {
+		return fmt.Errorf("failed to check source tag: %w", err)
+	}
+	if taken > 0 {
+		return fmt.Errorf("%w: slug '%s' is used by another tag", domain.ErrMergeUndoConflict, mergeLog.SourceSlug)
+	}
+
+	// Source slug must still be (only) a historic slug of target, and so must the moved old slugs
+	var historyTaken int64
+	if err := tx.Model(&domain.TagSlugHistory{}).
+		Where("slug = ? AND canonical_tag_id <> ?", mergeLog.SourceSlug, mergeLog.TargetTagID).
+		Count(&historyTaken).Error; err != nil {
+		return fmt.Errorf("failed to check slug history: %w", err)
+	}
+	if historyTaken > 0 {
+		return fmt.Errorf("%w: slug '%s' is used by another tag", domain.ErrMergeUndoConflict, mergeLog.SourceSlug)
+	}
+
+	var slugsOnTarget int64
+	if err := tx.Model(&domain.TagSlugHistory{}).
+		Where("canonical_tag_id = ?", mergeLog.TargetTagID).
+		Where("slug IN (SELECT slug FROM tag_merge_log_slugs WHERE merge_log_id = ?)", mergeLog.ID).
+		Count(&slugsOnTarget).Error; err != nil {
+		return fmt.Errorf("failed to check moved slugs: %w", err)
+	}
+	if int(slugsOnTarget) != len(mergeLog.Slugs) {
+		return fmt.Errorf("%w: %d of %d old slugs were reused or removed",
+			domain.ErrMergeUndoConflict, len(mergeLog.Slugs)-int(slugsOnTarget), len(mergeLog.Slugs))
+	}
+
+	var aliasesOnTarget int64
+	if err := tx.Model(&domain.TagAlias{}).
+		Where("canonical_tag_id = ?", mergeLog.TargetTagID).
+		Where("id IN (SELECT alias_id FROM tag_merge_log_aliases WHERE merge_log_id = ?)", mergeLog.ID).
+		Count(&aliasesOnTarget).Error; err != nil {
+		return fmt.Errorf("failed to check moved aliases: %w", err)
+	}
+	if int(aliasesOnTarget) != len(mergeLog.Aliases) {
+		return fmt.Errorf("%w: %d of %d moved aliases were changed or deleted",
+			domain.ErrMergeUndoConflict, len(mergeLog.Aliases)-int(aliasesOnTarget), len(mergeLog.Aliases))
+	}
+
+	if mergeLog.SourceParentID != nil {
+		var parentCount int64
+		if err := tx.Model(&domain.CanonicalTag{}).
+			Where("id = ?", *mergeLog.SourceParentID).
+			Count(&parentCount).Error; err != nil {
+			return fmt.Errorf("failed to check source parent: %w", err)
+		}
+		if parentCount == 0 {
+			return fmt.Errorf("%w: parent of source tag no longer exists", domain.ErrMergeUndoConflict)
+		}
+	}
+
+	if mergeLog.TargetMoved && !sameParent(target.ParentID, mergeLog.SourceParentID) {
+		return fmt.Errorf("%w: target tag was moved in the hierarchy", domain.ErrMergeUndoConflict)
+	}
+
+	var childrenOnTarget int64
+	if err := tx.Model(&domain.CanonicalTag{}).
+		Where("parent_id = ?", mergeLog.TargetTagID).
+		Where("id IN (SELECT child_id FROM tag_merge_log_children WHERE merge_log_id = ?)", mergeLog.ID).
+		Count(&childrenOnTarget).Error; err != nil {
+		return fmt.Errorf("failed to check child tags: %w", err)
+	}
+	if int(childrenOnTarget) != len(mergeLog.Children) {
+		return fmt.Errorf("%w: %d of %d child tags were moved or deleted",
+			domain.ErrMergeUndoConflict, len(mergeLog.Children)-int(childrenOnTarget), len(mergeLog.Children))
+	}
+
+	var linksOnTarget int64
+	if err := tx.Table("video_canonical_tags").
+		Where("canonical_tag_id = ?", mergeLog.TargetTagID).
+		Where("video_id IN (SELECT video_id FROM tag_merge_log_videos WHERE merge_log_id = ?)", mergeLog.ID).
+		Count(&linksOnTarget).Error; err != nil {
+		return fmt.Errorf("failed to check video links: %w", err)
+	}
+	if int(linksOnTarget) != len(mergeLog.Videos) {
+		return fmt.Errorf("%w: %d of %d video links were removed",
+			domain.ErrMergeUndoConflict, len(mergeLog.Videos)-int(linksOnTarget), len(mergeLog.Videos))
+	}
+
+	return nil
+}
+
+// sameParent compares two optional parent IDs
+func sameParent(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ============================================================
+// Resolution Policy Implementation
+// ============================================================
+
+// resolutionPolicyID is the primary key of the single policy row
+const resolutionPolicyID = 1
+
+// GetResolutionPolicy returns the stored policy, or the default one if no admin saved a policy yet
+func (r *tagRepository) GetResolutionPolicy(ctx context.Context) (*domain.ResolutionPolicy, error) {
+	return loadResolutionPolicy(r.db.WithContext(ctx))
+}
+
+// UpdateResolutionPolicy applies update to the current settings and writes the audit entry (atomic transaction)
+// The row is locked so concurrent updates are applied one after the other and each audit entry has the right "before"
+func (r *tagRepository) UpdateResolutionPolicy(ctx context.Context, update func(domain.ResolutionPolicySettings) (domain.ResolutionPolicySettings, error), changedBy uuid.UUID, reason string) (*domain.ResolutionPolicy, error) {
+	var saved *domain.ResolutionPolicy
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockTagKeys(tx, "resolution-policy"); err != nil {
+			return err
+		}
+
+		current, err := loadResolutionPolicy(tx)
+		if err != nil {
+			return err
+		}
+
+		after, err := update(current.ResolutionPolicySettings)
+		if err != nil {
+			return err
+		}
+
+		policy := &domain.ResolutionPolicy{
+			ID:                       resolutionPolicyID,
+			ResolutionPolicySettings: after,
+			UpdatedBy:                &changedBy,
+			UpdatedAt:                time.Now(),
+		}
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(policy).Error; err != nil {
+			return fmt.Errorf("failed to save resolution policy: %w", err)
+		}
+
+		change := &domain.ResolutionPolicyChange{
+			ChangedBy: changedBy,
+			Before:    current.ResolutionPolicySettings,
+			After:     after,
+			Reason:    reason,
+		}
+		if err := tx.Create(change).Error; err != nil {
+			return fmt.Errorf("failed to write policy audit entry: %w", err)
+		}
+
+		saved = policy
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+// ListResolutionPolicyChanges returns the policy audit trail, newest first
+func (r *tagRepository) ListResolutionPolicyChanges(ctx context.Context, page, limit int) ([]domain.ResolutionPolicyChange, int64, error) {
+	var changes []domain.ResolutionPolicyChange
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&domain.ResolutionPolicyChange{})
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count policy changes: %w", err)
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Order("created_at DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&changes).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list policy changes: %w", err)
+	}
+
+	return changes, total, nil
+}
+
+// loadResolutionPolicy reads the policy row, falling back to the defaults
+func loadResolutionPolicy(db *gorm.DB) (*domain.ResolutionPolicy, error) {
+	var policy domain.ResolutionPolicy
+	err := db.First(&policy, "id = ?", resolutionPolicyID).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.DefaultResolutionPolicy(), nil
+		}
+		return nil, fmt.Errorf("failed to load resolution policy: %w", err)
+	}
+	if policy.LanguageDistances == nil {
+		policy.LanguageDistances = map[string]float64{}
+	}
+
+	return &policy, nil
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ============================================================
+// User Tag Proposals Implementation
+// ============================================================
+
+// CreateTagProposal stores a pending proposal
+// Returns ErrProposalExists if the video already has a pending proposal with the same normalized text
+func (r *tagRepository) CreateTagProposal(ctx context.Context, proposal *domain.TagProposal) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Serialize with concurrent proposals of the same tag on the same video
+		if err := lockTagKeys(tx, "proposal:"+proposal.VideoID.String()+":"+proposal.NormalizedText); err != nil {
+			return err
+		}
+
+		var pending int64
+		if err := tx.Model(&domain.TagProposal{}).
+			Where("video_id = ? AND normalized_text = ? AND status = ?", proposal.VideoID, proposal.NormalizedText, domain.TagProposalPending).
+			Count(&pending).Error; err != nil {
+			return fmt.Errorf("failed to check pending proposals: %w", err)
+		}
+		if pending > 0 {
+			return domain.ErrProposalExists
+		}
+
+		if err := tx.Omit("Video", "Proposer", "CanonicalTag").Create(proposal).Error; err != nil {
+			return fmt.Errorf("failed to create tag proposal: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetTagProposalByID retrieves a proposal with its video, proposer and linked tag
+func (r *tagRepository) GetTagProposalByID(ctx context.Context, id uuid.UUID) (*domain.TagProposal, error) {
+	var proposal domain.TagProposal
+	err := r.db.WithContext(ctx).
+		Preload("Video").
+		Preload("Proposer").
+		Preload("CanonicalTag").
+		First(&proposal, "id = ?", id).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrProposalNotFound
+		}
+		return nil, fmt.Errorf("failed to get tag proposal: %w", err)
+	}
+
+	return &proposal, nil
+}
+
+// ListTagProposals returns proposals matching the filter, oldest first for the pending queue, newest first otherwise
+func (r *tagRepository) ListTagProposals(ctx context.Context, filter domain.TagProposalFilter, page, limit int) ([]domain.TagProposal, int64, error) {
+	var proposals []domain.TagProposal
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&domain.TagProposal{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.ProposerID != nil {
+		query = query.Where("proposer_id = ?", *filter.ProposerID)
+	}
+	if filter.VideoID != nil {
+		query = query.Where("video_id = ?", *filter.VideoID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count tag proposals: %w", err)
+	}
+
+	order := "created_at DESC"
+	if filter.Status == domain.TagProposalPending {
+		order = "created_at ASC" // Queue: first come, first served
+	}
+
+	offset := (page - 1) * limit
+	if err := query.
+		Preload("Video").
+		Preload("Proposer").
+		Preload("CanonicalTag").
+		Order(order).
+		Offset(offset).
+		Limit(limit).
+		Find(&proposals).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list tag proposals: %w", err)
+	}
+
+	return proposals, total, nil
+}
+
+// ApproveTagProposal marks a pending proposal approved and links its tag to the video (atomic transaction)
+// With approveTag the canonical tag is also marked approved (publicly visible)
+// Returns ErrProposalReviewed if the proposal is no longer pending
+func (r *tagRepository) ApproveTagProposal(ctx context.Context, id, canonicalTagID, reviewerID uuid.UUID, approveTag bool) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the proposal so two mods cannot review it at the same time
+		var proposal domain.TagProposal
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&proposal, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrProposalNotFound
+			}
+			return fmt.Errorf("failed to get tag proposal: %w", err)
+		}
+		if proposal.Status != domain.TagProposalPending {
+			return domain.ErrProposalReviewed
+		}
+
+		if err := tx.Model(&domain.TagProposal{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"status":           domain.TagProposalApproved,
+				"canonical_tag_id": canonicalTagID,
+				"reviewed_by":      reviewerID,
+				"reviewed_at":      time.Now(),
+			}).Error; err != nil {
+			return fmt.Errorf("failed to approve tag proposal: %w", err)
+		}
+
+		link := tx.Exec(`
+			INSERT INTO video_canonical_tags (video_id, canonical_tag_id)
+			VALUES (?, ?)
+			ON CONFLICT (video_id, canonical_tag_id) DO NOTHING
+		`, proposal.VideoID, canonicalTagID)
+		if link.Error != nil {
+			return fmt.Errorf("failed to link tag to video: %w", link.Error)
+		}
+		if err := adjustTagCounts(tx, canonicalTagID, link.RowsAffected, 0); err != nil {
+			return err
+		}
+
+		if approveTag {
+			if err := tx.Model(&domain.CanonicalTag{}).
+				Where("id = ?", canonicalTagID).
+				Update("is_approved", true).Error; err != nil {
+				return fmt.Errorf("failed to approve canonical tag: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// RejectTagProposal marks a pending proposal rejected with the reviewer's reason
+// Returns ErrProposalReviewed if the proposal is no longer pending
+func (r *tagRepository) RejectTagProposal(ctx context.Context, id, reviewerID uuid.UUID, reason string) error {
+	result := r.db.WithContext(ctx).
+		Model(&domain.TagProposal{}).
+		Where("id = ? AND status = ?", id, domain.TagProposalPending).
+		Updates(map[string]interface{}{
+			"status":           domain.TagProposalRejected,
+			"rejection_reason": reason,
+			"reviewed_by":      reviewerID,
+			"reviewed_at":      time.Now(),
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to reject tag proposal: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return proposalNotPending(r.db.WithContext(ctx), id)
+	}
+
+	return nil
+}
+
+// proposalNotPending distinguishes "missing" from "already reviewed" after an update matched no row
+func proposalNotPending(db *gorm.DB, id uuid.UUID) error {
+	var count int64
+	if err := db.Model(&domain.TagProposal{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to get tag proposal: %w", err)
+	}
+	if count == 0 {
+		return domain.ErrProposalNotFound
+	}
+	return domain.ErrProposalReviewed
+}
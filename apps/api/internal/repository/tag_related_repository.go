@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// relatedInsertBatchSize is the number of relations inserted per statement
+const relatedInsertBatchSize = 500
+
+// ============================================================
+// Related Tags Implementation
+// ============================================================
+
+// FindRelatedTagPairs returns directed tag pairs that co-occur on videos
+// or whose alias-embedding centroids (canonical_tags.centroid) are among the neighborsPerTag closest
+func (r *tagRepository) FindRelatedTagPairs(ctx context.Context, neighborsPerTag int) ([]domain.RelatedTagPair, error) {
+	var pairs []domain.RelatedTagPair
+
+	// live_links: tag links of videos that are not in the trash
+	// tag_counts: videos per tag
+	// co:         videos shared by each (tag, other tag) pair
+	// near:       K closest centroids per tag (pairs that never co-occur yet),
+	//             one KNN lookup per tag on the HNSW-indexed canonical_tags.centroid
+	sqlQuery := `
+		WITH live_links AS (
+			SELECT vct.video_id, vct.canonical_tag_id
+			FROM video_canonical_tags vct
+			JOIN videos v ON v.id = vct.video_id AND v.deleted_at IS NULL
+		),
+		tag_counts AS (
+			SELECT canonical_tag_id AS tag_id, COUNT(*) AS video_count
+			FROM live_links
+			GROUP BY canonical_tag_id
+		),
+		co AS (
+			SELECT a.canonical_tag_id AS tag_id, b.canonical_tag_id AS related_tag_id, COUNT(*) AS co_count
+			FROM live_links a
+			JOIN live_links b ON b.video_id = a.video_id AND b.canonical_tag_id <> a.canonical_tag_id
+			GROUP BY a.canonical_tag_id, b.canonical_tag_id
+		),
+		near AS (
+			SELECT c.id AS tag_id, nb.id AS related_tag_id
+			FROM canonical_tags c
+			CROSS JOIN LATERAL (
+				SELECT o.id
+				FROM canonical_tags o
+				WHERE o.id <> c.id
+					AND o.centroid IS NOT NULL
+				ORDER BY o.centroid <=> c.centroid
+				LIMIT ?
+			) nb
+			WHERE c.centroid IS NOT NULL
+		),
+		pairs AS (
+			SELECT tag_id, related_tag_id FROM co
+			UNION
+			SELECT tag_id, related_tag_id FROM near
+		)
+		SELECT
+			p.tag_id,
+			p.related_tag_id,
+			COALESCE(co.co_count, 0) AS co_occurrence_count,
+			COALESCE(co.co_count::float / NULLIF(ta.video_count + tb.video_count - co.co_count, 0), 0) AS jaccard,
+			ca.centroid <=> cb.centroid AS centroid_distance
+		FROM pairs p
+		LEFT JOIN co ON co.tag_id = p.tag_id AND co.related_tag_id = p.related_tag_id
+		LEFT JOIN tag_counts ta ON ta.tag_id = p.tag_id
+		LEFT JOIN tag_counts tb ON tb.tag_id = p.related_tag_id
+		JOIN canonical_tags ca ON ca.id = p.tag_id
+		JOIN canonical_tags cb ON cb.id = p.related_tag_id
+	`
+
+	if err := r.db.WithContext(ctx).Raw(sqlQuery, neighborsPerTag).Scan(&pairs).Error; err != nil {
+		return nil, fmt.Errorf("related tag pair search failed: %w", err)
+	}
+
+	return pairs, nil
+}
+
+// ReplaceTagRelations replaces all stored relations with the given ones (atomic transaction)
+// Readers keep seeing the previous relations until the transaction commits
+func (r *tagRepository) ReplaceTagRelations(ctx context.Context, relations []domain.TagRelation) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM tag_relations").Error; err != nil {
+			return fmt.Errorf("failed to clear tag relations: %w", err)
+		}
+
+		if len(relations) == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(relations, relatedInsertBatchSize).Error; err != nil {
+			return fmt.Errorf("failed to insert tag relations: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetRelatedTags returns the stored relations of a tag, highest score first
+// Relations pointing to deleted (or, with approvedOnly, unapproved) tags are skipped
+func (r *tagRepository) GetRelatedTags(ctx context.Context, tagID uuid.UUID, limit int, approvedOnly bool) ([]domain.RelatedTag, error) {
+	var relations []domain.TagRelation
+
+	query := r.db.WithContext(ctx).
+		Model(&domain.TagRelation{}).
+		Joins("JOIN canonical_tags ON canonical_tags.id = tag_relations.related_tag_id").
+		Where("tag_relations.tag_id = ?", tagID)
+	if approvedOnly {
+		query = query.Where("canonical_tags.is_approved = ?", true)
+	}
+
+	if err := query.
+		Order("tag_relations.score DESC").
+		Limit(limit).
+		Find(&relations).Error; err != nil {
+		return nil, fmt.Errorf("failed to get related tags: %w", err)
+	}
+
+	if len(relations) == 0 {
+		return []domain.RelatedTag{}, nil
+	}
+
+	ids := make([]uuid.UUID, len(relations))
+	for i, rel := range relations {
+		ids[i] = rel.RelatedTagID
+	}
+
+	var tags []domain.CanonicalTag
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&tags).Error; err != nil {
+		return nil, fmt.Errorf("failed to load related tags: %w", err)
+	}
+	tagsByID := make(map[uuid.UUID]domain.CanonicalTag, len(tags))
+	for _, t := range tags {
+		tagsByID[t.ID] = t
+	}
+
+	related := make([]domain.RelatedTag, 0, len(relations))
+	for _, rel := range relations {
+		tag, ok := tagsByID[rel.RelatedTagID]
+		if !ok {
+			continue // Deleted between the two queries
+		}
+		related = append(related, domain.RelatedTag{Tag: tag, Relation: rel})
+	}
+
+	return related, nil
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ============================================================
+// Rename & Slug History Implementation
+// ============================================================
+
+// RenameCanonicalTag sets a new display name and the first free slug from slugCandidates
+// The old slug is kept in tag_slug_history; oldNameAlias (optional) is added unless its text exists
+// Returns ErrSlugTaken if every candidate is used by another tag (current or historic slug)
+func (r *tagRepository) RenameCanonicalTag(ctx context.Context, tagID uuid.UUID, displayName string, slugCandidates []string, oldNameAlias *domain.TagAlias) (*domain.CanonicalTag, error) {
+	var tag domain.CanonicalTag
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&tag, "id = ?", tagID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrCanonicalTagNotFound
+			}
+			return fmt.Errorf("failed to lock tag: %w", err)
+		}
+
+		// Every candidate may be claimed concurrently (as a base slug or a suffixed variant):
+		// lock them all, with the same keys as CreateCanonicalTag and SplitAlias
+		slugKeys := make([]string, len(slugCandidates))
+		for i, candidate := range slugCandidates {
+			slugKeys[i] = "slug:" + candidate
+		}
+		if err := lockTagKeys(tx, slugKeys...); err != nil {
+			return err
+		}
+
+		// Skip slugs held by OTHER tags, now or in the past (historic slugs must keep redirecting)
+		newSlug, err := firstFreeSlug(tx, slugCandidates, tagID)
+		if err != nil {
+			return err
+		}
+		if newSlug == "" {
+			return fmt.Errorf("%w: '%s' and its %d variants", domain.ErrSlugTaken, slugCandidates[0], len(slugCandidates)-1)
+		}
+
+		if newSlug != tag.Slug {
+			// Keep old slug for redirects
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&domain.TagSlugHistory{
+				CanonicalTagID: tag.ID,
+				Slug:           tag.Slug,
+			}).Error; err != nil {
+				return fmt.Errorf("failed to record slug history: %w", err)
+			}
+
+			// Renaming back to a historic slug: it is current again
+			if err := tx.Where("canonical_tag_id = ? AND slug = ?", tag.ID, newSlug).
+				Delete(&domain.TagSlugHistory{}).Error; err != nil {
+				return fmt.Errorf("failed to clean slug history: %w", err)
+			}
+		}
+
+		if err := tx.Model(&tag).Updates(map[string]interface{}{
+			"display_name": displayName,
+			"slug":         newSlug,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to rename tag: %w", err)
+		}
+		tag.DisplayName = displayName
+		tag.Slug = newSlug
+
+		if oldNameAlias != nil {
+			oldNameAlias.CanonicalTagID = tag.ID
+			result := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "normalized_text"}},
+				DoNothing: true,
+			}).Create(oldNameAlias)
+			if result.Error != nil {
+				return fmt.Errorf("failed to keep old name as alias: %w", result.Error)
+			}
+			if err := adjustTagCounts(tx, tag.ID, 0, result.RowsAffected); err != nil {
+				return err
+			}
+			if err := refreshCentroids(tx, tag.ID); err != nil {
+				return err
+			}
+			tag.AliasCount += result.RowsAffected
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+// GetCanonicalBySlugHistory finds the tag that previously used a slug
+// Returns nil if the slug was never used (not an error)
+func (r *tagRepository) GetCanonicalBySlugHistory(ctx context.Context, slug string) (*domain.CanonicalTag, error) {
+	var canonical domain.CanonicalTag
+	err := r.db.WithContext(ctx).
+		Joins("JOIN tag_slug_history h ON h.canonical_tag_id = canonical_tags.id").
+		Where("h.slug = ?", slug).
+		First(&canonical).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query slug history: %w", err)
+	}
+
+	return &canonical, nil
+}
@@ -4,11 +4,15 @@ import (
 	"api/internal/domain"
 	"api/internal/infrastructure"
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type tagRepository struct {
@@ -36,6 +40,15 @@ func (r *tagRepository) TranslateText(ctx context.Context, text string) (string,
 	return r.openAIClient.TranslateToEnglish(ctx, text)
 }
 
+// TranslateTexts translates many terms to English in one OpenAI call
+// Returns empty strings if OpenAI client is not available (graceful degradation)
+func (r *tagRepository) TranslateTexts(ctx context.Context, texts []string) ([]string, error) {
+	if r.openAIClient == nil {
+		return make([]string, len(texts)), nil // Skip translation if OpenAI client not available
+	}
+	return r.openAIClient.BatchTranslateToEnglish(ctx, texts)
+}
+
 // GetEmbeddingForText generates embedding vector for given text using OpenAI
 func (r *tagRepository) GetEmbeddingForText(ctx context.Context, text string) ([]float32, error) {
 	if r.openAIClient == nil {
@@ -54,6 +67,33 @@ func (r *tagRepository) GetEmbeddingForText(ctx context.Context, text string) ([
 	return vectorSlice, nil
 }
 
+// GetEmbeddingsForTexts embeds many texts with BatchGetEmbeddings (one API call per chunk)
+// Result order matches texts
+func (r *tagRepository) GetEmbeddingsForTexts(ctx context.Context, texts []string) ([][]float32, error) {
+	if r.openAIClient == nil {
+		return nil, fmt.Errorf("OpenAI client not available")
+	}
+
+	const chunkSize = 100 // Keep requests well below the API input limit
+
+	results := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += chunkSize {
+		end := min(start+chunkSize, len(texts))
+
+		embeddings, err := r.openAIClient.BatchGetEmbeddings(ctx, texts[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate embeddings: %w", err)
+		}
+		for _, embedding := range embeddings {
+			vectorSlice := make([]float32, len(embedding.Slice()))
+			copy(vectorSlice, embedding.Slice())
+			results = append(results, vectorSlice)
+		}
+	}
+
+	return results, nil
+}
+
 // ============================================================
 // Canonical-Alias Architecture Implementation
 // ============================================================
@@ -88,64 +128,78 @@ func (r *tagRepository) GetCanonicalByAlias(ctx context.Context, normalizedText
 	return &canonical, nil
 }
 
+// GetCanonicalsByAliases finds the canonical tags of many normalized texts (batched Layer 1)
+// One query for the aliases, one for their canonicals - independent of the number of texts
+func (r *tagRepository) GetCanonicalsByAliases(ctx context.Context, normalizedTexts []string) (map[string]*domain.CanonicalTag, error) {
+	result := make(map[string]*domain.CanonicalTag)
+	if len(normalizedTexts) == 0 {
+		return result, nil
+	}
+
+	var aliases []domain.TagAlias
+	if err := r.db.WithContext(ctx).
+		Select("normalized_text", "canonical_tag_id").
+		Where("normalized_text IN ?", normalizedTexts).
+		Find(&aliases).Error; err != nil {
+		return nil, fmt.Errorf("failed to query aliases: %w", err)
+	}
+	if len(aliases) == 0 {
+		return result, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(aliases))
+	for _, alias := range aliases {
+		ids = append(ids, alias.CanonicalTagID)
+	}
+
+	var canonicals []domain.CanonicalTag
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&canonicals).Error; err != nil {
+		return nil, fmt.Errorf("failed to load canonical tags: %w", err)
+	}
+	byID := make(map[uuid.UUID]*domain.CanonicalTag, len(canonicals))
+	for i := range canonicals {
+		byID[canonicals[i].ID] = &canonicals[i]
+	}
+
+	for _, alias := range aliases {
+		if canonical, ok := byID[alias.CanonicalTagID]; ok {
+			result[alias.NormalizedText] = canonical
+		}
+	}
+	return result, nil
+}
+
 // GetClosestCanonical finds most similar canonical tag using vector search (Layer 3)
 // Returns (canonical, score, error)
 // If no match above threshold, returns (nil, 0, nil)
-func (r *tagRepository) GetClosestCanonical(ctx context.Context, embedding pgvector.Vector, threshold float64) (*domain.CanonicalTag, float64, error) {
+func (r *tagRepository) GetClosestCanonical(ctx context.Context, embedding pgvector.Vector, threshold float64, strategy domain.MatchStrategy) (*domain.CanonicalTag, float64, error) {
 	if r.openAIClient == nil {
 		return nil, 0, nil // No OpenAI = no semantic search
 	}
 
-	type resultRow struct {
-		ID             uuid.UUID
-		CanonicalTagID uuid.UUID
-		RawText        string
-		Distance       float64
-	}
-
-	var result resultRow
-
-	// Find closest alias using vector similarity
 	// cosine distance range: [0, 2]
 	// 0 = identical, 1 = orthogonal, 2 = opposite
 	// threshold parameter is DISTANCE (not similarity %)
 	// Example: threshold=0.30 means distance < 0.30 (85% similarity)
-	sqlQuery := `
-		SELECT 
-			id,
-			canonical_tag_id,
-			raw_text,
-			embedding <=> $1::vector as distance
-		FROM tag_aliases
-		WHERE embedding IS NOT NULL
-			AND embedding <=> $1::vector < $2
-		ORDER BY embedding <=> $1::vector ASC
-		LIMIT 1
-	`
-
-	err := r.db.WithContext(ctx).Raw(sqlQuery, embedding, threshold).Scan(&result).Error
-
+	matches, err := r.GetNearestCanonicals(ctx, embedding, strategy, 1)
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, 0, nil // No match found
-		}
 		return nil, 0, fmt.Errorf("vector search failed: %w", err)
 	}
 
-	// If no results returned
-	if result.ID == uuid.Nil {
+	// No match below threshold
+	if len(matches) == 0 || matches[0].Distance >= threshold {
 		return nil, 0, nil
 	}
 
 	// Convert distance to similarity score (0-1 range)
 	// similarity = 1 - (distance / 2)
 	// Example: distance=0.3 → similarity=0.85 (85%)
-	similarityScore := 1.0 - (result.Distance / 2.0)
+	similarityScore := 1.0 - (matches[0].Distance / 2.0)
 
 	// Load canonical tag
 	var canonical domain.CanonicalTag
 	err = r.db.WithContext(ctx).
-		Where("id = ?", result.CanonicalTagID).
+		Where("id = ?", matches[0].CanonicalTagID).
 		First(&canonical).Error
 
 	if err != nil {
@@ -155,30 +209,105 @@ func (r *tagRepository) GetClosestCanonical(ctx context.Context, embedding pgvec
 	return &canonical, similarityScore, nil
 }
 
+// GetNearestAliases returns the top-K aliases closest to the embedding (no threshold filter)
+// Read-only query used by dry-run resolution to show why Layer 3 would merge or not
+func (r *tagRepository) GetNearestAliases(ctx context.Context, embedding pgvector.Vector, limit int) ([]domain.AliasMatch, error) {
+	if r.openAIClient == nil {
+		return []domain.AliasMatch{}, nil // No OpenAI = no semantic search
+	}
+
+	var matches []domain.AliasMatch
+
+	sqlQuery := `
+		SELECT
+			ta.id AS alias_id,
+			ta.canonical_tag_id,
+			ct.display_name AS canonical_name,
+			ta.raw_text,
+			ta.embedding <=> $1::vector AS distance
+		FROM tag_aliases ta
+		JOIN canonical_tags ct ON ct.id = ta.canonical_tag_id
+		WHERE ta.embedding IS NOT NULL
+		ORDER BY ta.embedding <=> $1::vector ASC
+		LIMIT $2
+	`
+
+	if err := r.db.WithContext(ctx).Raw(sqlQuery, embedding, limit).Scan(&matches).Error; err != nil {
+		return nil, fmt.Errorf("nearest alias search failed: %w", err)
+	}
+
+	return matches, nil
+}
+
 // CreateCanonicalTag creates a new canonical tag with its initial alias (atomic transaction)
 func (r *tagRepository) CreateCanonicalTag(ctx context.Context, canonical *domain.CanonicalTag, initialAlias *domain.TagAlias) error {
 	// Use transaction to ensure atomicity
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Step 1: Create canonical tag
-		if err := tx.Create(canonical).Error; err != nil {
+		// Step 1: Serialize with concurrent resolutions of the same text / slug
+		if err := lockTagKeys(tx, "alias:"+initialAlias.NormalizedText, "slug:"+canonical.Slug); err != nil {
+			return err
+		}
+		taken, err := aliasTextTaken(tx, initialAlias.NormalizedText)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return fmt.Errorf("%w: '%s'", domain.ErrAliasTaken, initialAlias.NormalizedText)
+		}
+
+		// Step 2: Pick a free slug (another text may share it, e.g. "C#" and "C++" → "c")
+		candidates, err := domain.SlugCandidates(canonical.DisplayName, domain.MaxSlugSuffix)
+		if err != nil {
+			return err
+		}
+		slug, err := firstFreeSlug(tx, candidates, uuid.Nil)
+		if err != nil {
+			return err
+		}
+		if slug == "" {
+			return fmt.Errorf("%w: '%s' and its %d variants", domain.ErrSlugTaken, candidates[0], len(candidates)-1)
+		}
+		canonical.Slug = slug
+
+		// Step 3: Create canonical tag (counting its initial alias)
+		canonical.AliasCount = 1
+		if err := tx.Omit("Aliases").Create(canonical).Error; err != nil {
 			return fmt.Errorf("failed to create canonical tag: %w", err)
 		}
 
-		// Step 2: Set canonical_tag_id in alias
+		// Step 4: Create initial alias
 		initialAlias.CanonicalTagID = canonical.ID
-
-		// Step 3: Create initial alias
-		if err := tx.Create(initialAlias).Error; err != nil {
+		if err := insertAlias(tx, initialAlias); err != nil {
 			return fmt.Errorf("failed to create initial alias: %w", err)
 		}
 
-		return nil
+		return refreshCentroids(tx, canonical.ID)
 	})
 }
 
 // CreateAlias adds a new alias to existing canonical tag
+// Serialized per alias text like CreateCanonicalTag
 func (r *tagRepository) CreateAlias(ctx context.Context, alias *domain.TagAlias) error {
-	return r.db.WithContext(ctx).Create(alias).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockTagKeys(tx, "alias:"+alias.NormalizedText); err != nil {
+			return err
+		}
+		taken, err := aliasTextTaken(tx, alias.NormalizedText)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return fmt.Errorf("%w: '%s'", domain.ErrAliasTaken, alias.NormalizedText)
+		}
+
+		if err := insertAlias(tx, alias); err != nil {
+			return err
+		}
+		if err := refreshCentroids(tx, alias.CanonicalTagID); err != nil {
+			return err
+		}
+		return adjustTagCounts(tx, alias.CanonicalTagID, 0, 1)
+	})
 }
 
 // GetCanonicalByID retrieves canonical tag by ID
@@ -196,14 +325,33 @@ func (r *tagRepository) GetCanonicalByID(ctx context.Context, id uuid.UUID) (*do
 	return &canonical, nil
 }
 
+// GetCanonicalsByIDs retrieves canonical tags (with aliases) by ID; missing IDs are skipped
+func (r *tagRepository) GetCanonicalsByIDs(ctx context.Context, ids []uuid.UUID) ([]domain.CanonicalTag, error) {
+	var canonicals []domain.CanonicalTag
+	if len(ids) == 0 {
+		return canonicals, nil
+	}
+
+	if err := r.db.WithContext(ctx).
+		Preload("Aliases").
+		Where("id IN ?", ids).
+		Find(&canonicals).Error; err != nil {
+		return nil, fmt.Errorf("failed to get canonical tags: %w", err)
+	}
+
+	return canonicals, nil
+}
+
 // UpdateCanonicalTag updates a canonical tag
+// Usage counters are maintained by the repository and never overwritten from the struct
 func (r *tagRepository) UpdateCanonicalTag(ctx context.Context, canonical *domain.CanonicalTag) error {
-	return r.db.WithContext(ctx).Save(canonical).Error
+	return r.db.WithContext(ctx).Omit("video_count", "alias_count").Save(canonical).Error
 }
 
 // ListCanonicalTags returns a paginated list of canonical tags.
 // If approvedOnly is true, it returns only approved tags. Otherwise, it returns all tags.
-func (r *tagRepository) ListCanonicalTags(ctx context.Context, page, limit int, approvedOnly bool) ([]domain.CanonicalTag, int64, error) {
+// TagSortGrowing ranks by videos tagged since growthSince (ignored by other sorts)
+func (r *tagRepository) ListCanonicalTags(ctx context.Context, page, limit int, approvedOnly bool, sort domain.TagSort, growthSince time.Time) ([]domain.CanonicalTag, int64, error) {
 	var canonicals []domain.CanonicalTag
 	var total int64
 
@@ -220,9 +368,24 @@ func (r *tagRepository) ListCanonicalTags(ctx context.Context, page, limit int,
 		return nil, 0, err
 	}
 
+	// Sort order (display_name breaks ties)
+	switch sort {
+	case domain.TagSortPopular:
+		query = query.Order("video_count DESC").Order("display_name ASC")
+	case domain.TagSortGrowing:
+		query = query.Order(clause.OrderBy{Expression: clause.Expr{
+			SQL: `(SELECT COUNT(*) FROM video_canonical_tags vct
+				WHERE vct.canonical_tag_id = canonical_tags.id AND vct.created_at >= ?) DESC,
+				video_count DESC, display_name ASC`,
+			Vars: []interface{}{growthSince},
+		}})
+	default:
+		query = query.Order("display_name ASC")
+	}
+
 	// Query with pagination
 	offset := (page - 1) * limit
-	if err := query.Order("display_name ASC").Offset(offset).Limit(limit).Find(&canonicals).Error; err != nil {
+	if err := query.Offset(offset).Limit(limit).Find(&canonicals).Error; err != nil {
 		return nil, 0, err
 	}
 
@@ -230,7 +393,7 @@ func (r *tagRepository) ListCanonicalTags(ctx context.Context, page, limit int,
 }
 
 // SearchCanonicalTags searches canonical tags using hybrid approach
-func (r *tagRepository) SearchCanonicalTags(ctx context.Context, query string, limit int, approvedOnly bool) ([]domain.CanonicalTag, error) {
+func (r *tagRepository) SearchCanonicalTags(ctx context.Context, query string, limit int, approvedOnly bool, strategy domain.MatchStrategy) ([]domain.CanonicalTag, error) {
 	var canonicals []domain.CanonicalTag
 
 	// Phase 1: SQL LIKE search (fast & free)
@@ -252,7 +415,7 @@ func (r *tagRepository) SearchCanonicalTags(ctx context.Context, query string, l
 		return canonicals, nil
 	}
 
-	// Phase 2: Vector search via aliases
+	// Phase 2: Vector search via aliases and/or centroids
 	if r.openAIClient == nil {
 		return canonicals, nil // No OpenAI = return empty
 	}
@@ -264,25 +427,9 @@ func (r *tagRepository) SearchCanonicalTags(ctx context.Context, query string, l
 		return canonicals, nil
 	}
 
-	// Find similar aliases
-	type resultRow struct {
-		CanonicalTagID uuid.UUID
-		Distance       float64
-	}
-	var results []resultRow
-
-	sqlQuery := `
-		SELECT DISTINCT
-			canonical_tag_id,
-			MIN(embedding <=> $1::vector) as distance
-		FROM tag_aliases
-		WHERE embedding IS NOT NULL
-		GROUP BY canonical_tag_id
-		ORDER BY distance ASC
-		LIMIT $2
-	`
-
-	if err := r.db.WithContext(ctx).Raw(sqlQuery, embedding, limit).Scan(&results).Error; err != nil {
+	// Find similar canonicals (closest alias, centroid or both)
+	results, err := r.GetNearestCanonicals(ctx, embedding, strategy, limit)
+	if err != nil {
 		return nil, fmt.Errorf("vector search failed: %w", err)
 	}
 
@@ -317,29 +464,83 @@ func (r *tagRepository) AddCanonicalTagToVideo(ctx context.Context, videoID, can
 	// Check if relationship already exists
 	// Insert relationship, ignoring if it already exists to prevent duplicates.
 	// This is an atomic operation and avoids race conditions.
-	return r.db.WithContext(ctx).
-		Exec(`
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(`
 			INSERT INTO video_canonical_tags (video_id, canonical_tag_id) 
 			VALUES (?, ?)
 			ON CONFLICT (video_id, canonical_tag_id) DO NOTHING
-		`, videoID, canonicalTagID).
-		Error
+		`, videoID, canonicalTagID)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		// Only count links that did not exist yet
+		return adjustTagCounts(tx, canonicalTagID, result.RowsAffected, 0)
+	})
+}
+
+// AddCanonicalTagsToVideos links many (video, tag) pairs at once, skipping existing links
+// Returns only the links that were created (counters are updated for those)
+func (r *tagRepository) AddCanonicalTagsToVideos(ctx context.Context, links []domain.VideoTagLink) ([]domain.VideoTagLink, error) {
+	const chunkSize = 500
+
+	var created []domain.VideoTagLink
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(links); start += chunkSize {
+			chunk := links[start:min(start+chunkSize, len(links))]
+
+			placeholders := make([]string, len(chunk))
+			args := make([]interface{}, 0, len(chunk)*2)
+			for i, link := range chunk {
+				placeholders[i] = "(?, ?)"
+				args = append(args, link.VideoID, link.CanonicalTagID)
+			}
+
+			var inserted []domain.VideoTagLink
+			if err := tx.Raw(`
+				INSERT INTO video_canonical_tags (video_id, canonical_tag_id)
+				VALUES `+strings.Join(placeholders, ", ")+`
+				ON CONFLICT (video_id, canonical_tag_id) DO NOTHING
+				RETURNING video_id, canonical_tag_id
+			`, args...).Scan(&inserted).Error; err != nil {
+				return fmt.Errorf("failed to link tags to videos: %w", err)
+			}
+			created = append(created, inserted...)
+		}
+
+		perTag := make(map[uuid.UUID]int64)
+		for _, link := range created {
+			perTag[link.CanonicalTagID]++
+		}
+		for tagID, n := range perTag {
+			if err := adjustTagCounts(tx, tagID, n, 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
 }
 
 // RemoveCanonicalTagFromVideo unlinks a canonical tag from a video
 func (r *tagRepository) RemoveCanonicalTagFromVideo(ctx context.Context, videoID, canonicalTagID uuid.UUID) error {
-	result := r.db.WithContext(ctx).
-		Exec("DELETE FROM video_canonical_tags WHERE video_id = ? AND canonical_tag_id = ?", videoID, canonicalTagID)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec("DELETE FROM video_canonical_tags WHERE video_id = ? AND canonical_tag_id = ?", videoID, canonicalTagID)
 
-	if result.Error != nil {
-		return result.Error
-	}
+		if result.Error != nil {
+			return result.Error
+		}
 
-	if result.RowsAffected == 0 {
-		return fmt.Errorf("tag not found on video")
-	}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("tag not found on video")
+		}
 
-	return nil
+		return adjustTagCounts(tx, canonicalTagID, -1, 0)
+	})
 }
 
 // GetCanonicalTagsByVideoID returns all canonical tags for a video
@@ -367,11 +568,13 @@ func (r *tagRepository) GetCanonicalTagsByVideoID(ctx context.Context, videoID u
 
 // MergeTags merges source canonical tag into target canonical tag
 // Transaction ensures atomicity:
-// 1. Move all aliases from source to target
-// 2. Update video relationships from source to target
-// 3. Delete source canonical tag
-func (r *tagRepository) MergeTags(ctx context.Context, sourceID, targetID uuid.UUID) (int, error) {
-	var mergedCount int
+// 1. Snapshot source tag, its aliases and video links into a merge log (for undo)
+// 2. Re-parent source's child tags to target
+// 3. Move all aliases from source to target
+// 4. Update video relationships from source to target
+// 5. Delete source canonical tag
+func (r *tagRepository) MergeTags(ctx context.Context, sourceID, targetID uuid.UUID, mergedBy *uuid.UUID) (*domain.TagMergeLog, error) {
+	var mergeLog *domain.TagMergeLog
 
 	// Use transaction to ensure atomicity
 	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
@@ -392,7 +595,27 @@ func (r *tagRepository) MergeTags(ctx context.Context, sourceID, targetID uuid.U
 			return fmt.Errorf("failed to find target tag: %w", err)
 		}
 
-		// 2. Move all aliases from source to target
+		// 2. Record merge log before anything moves
+		var err error
+		mergeLog, err = recordMergeLog(tx, &sourceTag, targetID, mergedBy)
+		if err != nil {
+			return err
+		}
+
+		// 3. Keep the tag hierarchy valid (children of source move to target)
+		if err := reparentForMerge(tx, mergeLog, &sourceTag, &targetTag); err != nil {
+			return err
+		}
+
+		// 4. Old URLs of source redirect to target; drop rows tied to source alone
+		if err := moveSlugsForMerge(tx, mergeLog); err != nil {
+			return err
+		}
+		if err := clearSourceForMerge(tx, mergeLog); err != nil {
+			return err
+		}
+
+		// 5. Move all aliases from source to target
 		result := tx.Model(&domain.TagAlias{}).
 			Where("canonical_tag_id = ?", sourceID).
 			Update("canonical_tag_id", targetID)
@@ -400,9 +623,8 @@ func (r *tagRepository) MergeTags(ctx context.Context, sourceID, targetID uuid.U
 		if result.Error != nil {
 			return fmt.Errorf("failed to move aliases: %w", result.Error)
 		}
-		mergedCount = int(result.RowsAffected)
 
-		// 3. Update video relationships: Replace source with target
+		// 6. Update video relationships: Replace source with target
 		// Use raw SQL to handle potential duplicates (ignore conflicts)
 		if err := tx.Exec(`
 			INSERT INTO video_canonical_tags (video_id, canonical_tag_id)
@@ -414,24 +636,100 @@ func (r *tagRepository) MergeTags(ctx context.Context, sourceID, targetID uuid.U
 			return fmt.Errorf("failed to update video relationships: %w", err)
 		}
 
-		// 4. Delete old video relationships with source tag
+		// 7. Delete old video relationships with source tag
 		if err := tx.Exec("DELETE FROM video_canonical_tags WHERE canonical_tag_id = ?", sourceID).Error; err != nil {
 			return fmt.Errorf("failed to delete old video relationships: %w", err)
 		}
 
-		// 5. Delete source canonical tag
+		// 8. Delete source canonical tag
 		if err := tx.Delete(&sourceTag).Error; err != nil {
 			return fmt.Errorf("failed to delete source canonical tag: %w", err)
 		}
 
+		// 9. Target gains the moved aliases and the live videos it did not have yet
+		if err := recountTags(tx, targetID); err != nil {
+			return err
+		}
+		if err := refreshCentroids(tx, targetID); err != nil {
+			return err
+		}
+
 		return nil
 	})
 
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+
+	return mergeLog, nil
+}
+
+// DeleteCanonicalTag unlinks a tag from all videos, deletes its aliases and the tag itself
+// Children are re-parented to the tag's parent so the subtree stays in the tree
+// Slug history and duplicate suggestions of the tag are removed as well
+func (r *tagRepository) DeleteCanonicalTag(ctx context.Context, tagID uuid.UUID) (videoCount, aliasCount int, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Children are re-parented below: same lock order as SetCanonicalParent
+		if err := lockTagKeys(tx, hierarchyLockKey); err != nil {
+			return err
+		}
+
+		var tag domain.CanonicalTag
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&tag, "id = ?", tagID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrCanonicalTagNotFound
+			}
+			return fmt.Errorf("failed to lock canonical tag: %w", err)
+		}
+
+		// 1. Keep children in the tree (move them one level up)
+		if err := tx.Model(&domain.CanonicalTag{}).
+			Where("parent_id = ?", tagID).
+			Update("parent_id", tag.ParentID).Error; err != nil {
+			return fmt.Errorf("failed to re-parent child tags: %w", err)
+		}
+
+		// 2. Unlink videos
+		result := tx.Exec("DELETE FROM video_canonical_tags WHERE canonical_tag_id = ?", tagID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to unlink videos: %w", result.Error)
+		}
+		videoCount = int(result.RowsAffected)
+
+		// 3. Delete aliases
+		result = tx.Where("canonical_tag_id = ?", tagID).Delete(&domain.TagAlias{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete aliases: %w", result.Error)
+		}
+		aliasCount = int(result.RowsAffected)
+
+		// 4. Free old slugs, drop localized names, related tags and duplicate suggestions pointing to the tag
+		if err := tx.Where("canonical_tag_id = ?", tagID).Delete(&domain.TagSlugHistory{}).Error; err != nil {
+			return fmt.Errorf("failed to delete slug history: %w", err)
+		}
+		if err := tx.Where("canonical_tag_id = ?", tagID).Delete(&domain.TagDisplayName{}).Error; err != nil {
+			return fmt.Errorf("failed to delete display names: %w", err)
+		}
+		if err := tx.Where("tag_id = ? OR related_tag_id = ?", tagID, tagID).Delete(&domain.TagRelation{}).Error; err != nil {
+			return fmt.Errorf("failed to delete tag relations: %w", err)
+		}
+		if err := tx.Where("tag_a_id = ? OR tag_b_id = ?", tagID, tagID).Delete(&domain.TagDuplicateCandidate{}).Error; err != nil {
+			return fmt.Errorf("failed to delete duplicate candidates: %w", err)
+		}
+
+		// 5. Delete the tag
+		if err := tx.Delete(&tag).Error; err != nil {
+			return fmt.Errorf("failed to delete canonical tag: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
 	}
 
-	return mergedCount, nil
+	return videoCount, aliasCount, nil
 }
 
 // GetAliasCountByCanonicalID returns the number of aliases for a canonical tag
@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ============================================================
+// Alias Review Queue Implementation
+// ============================================================
+
+// recordAliasReview stamps the alias with reviewer info and writes the review log
+// Must be called inside a transaction
+func recordAliasReview(tx *gorm.DB, review *domain.TagAliasReviewLog) error {
+	now := time.Now()
+
+	result := tx.Model(&domain.TagAlias{}).
+		Where("id = ?", review.AliasID).
+		Updates(map[string]interface{}{
+			"is_reviewed": true,
+			"reviewed_by": review.ReviewerID,
+			"reviewed_at": now,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark alias reviewed: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrAliasNotFound
+	}
+
+	review.CreatedAt = now
+	if err := tx.Create(review).Error; err != nil {
+		return fmt.Errorf("failed to write review log: %w", err)
+	}
+
+	return nil
+}
+
+// ListUnreviewedAliases returns aliases with is_reviewed = false, lowest similarity first
+func (r *tagRepository) ListUnreviewedAliases(ctx context.Context, page, limit int) ([]domain.TagAlias, int64, error) {
+	var aliases []domain.TagAlias
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&domain.TagAlias{}).Where("is_reviewed = ?", false)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count unreviewed aliases: %w", err)
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Omit("Embedding").
+		Order("similarity_score ASC, created_at ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&aliases).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list unreviewed aliases: %w", err)
+	}
+
+	return aliases, total, nil
+}
+
+// CountUnreviewedAliases returns the number of aliases waiting for review
+func (r *tagRepository) CountUnreviewedAliases(ctx context.Context) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.TagAlias{}).
+		Where("is_reviewed = ?", false).
+		Count(&count).Error
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unreviewed aliases: %w", err)
+	}
+
+	return count, nil
+}
+
+// alternativeNeighborsPerAlias is how many nearest foreign aliases are inspected per alias
+// Several of them may share a canonical, so it is a multiple of the alternatives shown
+const alternativeNeighborsPerAlias = 20
+
+// GetAlternativeCanonicals returns, for each alias, the canonicals closest to its embedding,
+// excluding the canonical the alias currently belongs to (one query for the whole page)
+// Distance of a canonical = MIN distance over its aliases among the nearest neighbors (HNSW index)
+func (r *tagRepository) GetAlternativeCanonicals(ctx context.Context, aliasIDs []uuid.UUID, limit int) (map[uuid.UUID][]domain.CanonicalMatch, error) {
+	result := make(map[uuid.UUID][]domain.CanonicalMatch, len(aliasIDs))
+	if len(aliasIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		AliasID        uuid.UUID
+		CanonicalTagID uuid.UUID
+		CanonicalName  string
+		Distance       float64
+	}
+
+	sqlQuery := `
+		SELECT alias_id, canonical_tag_id, canonical_name, distance
+		FROM (
+			SELECT
+				src.id AS alias_id,
+				nb.canonical_tag_id,
+				ct.display_name AS canonical_name,
+				MIN(nb.distance) AS distance,
+				ROW_NUMBER() OVER (PARTITION BY src.id ORDER BY MIN(nb.distance)) AS rank
+			FROM tag_aliases src
+			CROSS JOIN LATERAL (
+				SELECT ta.canonical_tag_id, ta.embedding <=> src.embedding AS distance
+				FROM tag_aliases ta
+				WHERE ta.canonical_tag_id <> src.canonical_tag_id
+					AND ta.embedding IS NOT NULL
+				ORDER BY ta.embedding <=> src.embedding
+				LIMIT ?
+			) nb
+			JOIN canonical_tags ct ON ct.id = nb.canonical_tag_id
+			WHERE src.id IN ?
+				AND src.embedding IS NOT NULL
+			GROUP BY src.id, nb.canonical_tag_id, ct.display_name
+		) ranked
+		WHERE rank <= ?
+		ORDER BY alias_id, distance ASC
+	`
+
+	if err := r.db.WithContext(ctx).Raw(sqlQuery, alternativeNeighborsPerAlias, aliasIDs, limit).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("alternative canonical search failed: %w", err)
+	}
+
+	for _, row := range rows {
+		result[row.AliasID] = append(result[row.AliasID], domain.CanonicalMatch{
+			CanonicalTagID: row.CanonicalTagID,
+			CanonicalName:  row.CanonicalName,
+			Distance:       row.Distance,
+		})
+	}
+	return result, nil
+}
+
+// GetTaggedVideos returns, for each canonical tag, a sample of its most recent videos
+func (r *tagRepository) GetTaggedVideos(ctx context.Context, canonicalIDs []uuid.UUID, limit int) (map[uuid.UUID][]domain.Video, error) {
+	result := make(map[uuid.UUID][]domain.Video, len(canonicalIDs))
+	if len(canonicalIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		CanonicalTagID uuid.UUID
+		ID             uuid.UUID
+		YoutubeID      string
+		Title          string
+	}
+
+	sqlQuery := `
+		SELECT canonical_tag_id, id, youtube_id, title
+		FROM (
+			SELECT
+				vct.canonical_tag_id,
+				v.id,
+				v.youtube_id,
+				v.title,
+				v.created_at,
+				ROW_NUMBER() OVER (PARTITION BY vct.canonical_tag_id ORDER BY v.created_at DESC) AS rank
+			FROM video_canonical_tags vct
+			JOIN videos v ON v.id = vct.video_id AND v.deleted_at IS NULL
+			WHERE vct.canonical_tag_id IN ?
+		) ranked
+		WHERE rank <= ?
+		ORDER BY canonical_tag_id, created_at DESC
+	`
+
+	if err := r.db.WithContext(ctx).Raw(sqlQuery, canonicalIDs, limit).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load tagged videos: %w", err)
+	}
+
+	for _, row := range rows {
+		result[row.CanonicalTagID] = append(result[row.CanonicalTagID], domain.Video{
+			ID:        row.ID,
+			YoutubeID: row.YoutubeID,
+			Title:     row.Title,
+		})
+	}
+	return result, nil
+}
+
+// AcceptAlias marks an alias reviewed by a mod and writes the review log (atomic transaction)
+func (r *tagRepository) AcceptAlias(ctx context.Context, review *domain.TagAliasReviewLog) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return recordAliasReview(tx, review)
+	})
+}
+
+// GetAliasReviewStats returns per-mod review counts since the given time
+func (r *tagRepository) GetAliasReviewStats(ctx context.Context, since time.Time) ([]domain.AliasReviewerStats, error) {
+	var stats []domain.AliasReviewerStats
+
+	sqlQuery := `
+		SELECT
+			l.reviewer_id,
+			COALESCE(u.username, '') AS username,
+			COUNT(*) FILTER (WHERE l.action = ?) AS accepted,
+			COUNT(*) FILTER (WHERE l.action = ?) AS reassigned,
+			COUNT(*) FILTER (WHERE l.action = ?) AS split,
+			COUNT(*) AS total,
+			MAX(l.created_at) AS last_reviewed_at
+		FROM tag_alias_review_logs l
+		LEFT JOIN users u ON u.id = l.reviewer_id
+		WHERE l.created_at >= ?
+		GROUP BY l.reviewer_id, u.username
+		ORDER BY total DESC
+	`
+
+	if err := r.db.WithContext(ctx).Raw(sqlQuery,
+		domain.AliasReviewAccept, domain.AliasReviewReassign, domain.AliasReviewSplit, since,
+	).Scan(&stats).Error; err != nil {
+		return nil, fmt.Errorf("failed to get alias review stats: %w", err)
+	}
+
+	return stats, nil
+}
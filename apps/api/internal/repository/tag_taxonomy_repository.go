@@ -0,0 +1,354 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+	"gorm.io/gorm"
+)
+
+// errTaxonomyDryRun rolls back the import transaction after the result is computed
+var errTaxonomyDryRun = errors.New("taxonomy import dry run")
+
+// ============================================================
+// Taxonomy Export / Import Implementation
+// ============================================================
+
+// ListAllCanonicalTags returns every canonical tag with its aliases, ordered by slug
+// Alias embeddings are only loaded when includeEmbeddings is true
+func (r *tagRepository) ListAllCanonicalTags(ctx context.Context, includeEmbeddings bool) ([]domain.CanonicalTag, error) {
+	var canonicals []domain.CanonicalTag
+
+	err := r.db.WithContext(ctx).
+		Preload("Aliases", func(db *gorm.DB) *gorm.DB {
+			if !includeEmbeddings {
+				db = db.Omit("Embedding")
+			}
+			return db.Order("created_at ASC")
+		}).
+		Order("slug ASC").
+		Find(&canonicals).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list canonical tags: %w", err)
+	}
+
+	return canonicals, nil
+}
+
+// ImportTaxonomy upserts tags by slug and aliases by normalized text (atomic transaction)
+// - tags are matched by current slug, then by historic slug (renamed in the target)
+// - an alias owned by another canonical in the target is reported, never moved
+// - parents are applied after all tags exist, with the same cycle check as SetCanonicalParent
+// With dryRun the transaction is rolled back and only the report is returned
+func (r *tagRepository) ImportTaxonomy(ctx context.Context, tags []domain.TaxonomyTag, dryRun bool) (*domain.TaxonomyImportResult, error) {
+	result := &domain.TaxonomyImportResult{}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Pass 2 rewrites parent_id: hold the hierarchy lock for the whole import
+		if err := lockTagKeys(tx, hierarchyLockKey); err != nil {
+			return err
+		}
+
+		slugToID := make(map[string]uuid.UUID, len(tags))
+		var touched []uuid.UUID
+
+		// Pass 1: tags and aliases
+		for _, t := range tags {
+			if t.Slug == "" || t.DisplayName == "" {
+				result.Conflicts = append(result.Conflicts, domain.TaxonomyConflict{
+					Kind: domain.TaxonomyConflictInvalidTag, Slug: t.Slug,
+					Message: "slug and display_name are required",
+				})
+				continue
+			}
+			if !domain.IsValidSlug(t.Slug) {
+				result.Conflicts = append(result.Conflicts, domain.TaxonomyConflict{
+					Kind: domain.TaxonomyConflictInvalidTag, Slug: t.Slug,
+					Message: "slug must be lowercase letters, digits and dashes (at most 100 characters)",
+				})
+				continue
+			}
+			if _, dup := slugToID[t.Slug]; dup {
+				result.Conflicts = append(result.Conflicts, domain.TaxonomyConflict{
+					Kind: domain.TaxonomyConflictInvalidTag, Slug: t.Slug,
+					Message: "slug appears more than once in the file",
+				})
+				continue
+			}
+
+			tagID, err := importTaxonomyTag(tx, t, result)
+			if err != nil {
+				return err
+			}
+			if tagID == uuid.Nil {
+				continue // Skipped (reported as conflict)
+			}
+			slugToID[t.Slug] = tagID
+			touched = append(touched, tagID)
+		}
+
+		// Pass 2: hierarchy (every tag of the file exists now)
+		for _, t := range tags {
+			tagID, ok := slugToID[t.Slug]
+			if !ok {
+				continue
+			}
+			if err := importTaxonomyParent(tx, tagID, t, slugToID, result); err != nil {
+				return err
+			}
+		}
+
+		if err := recountTags(tx, touched...); err != nil {
+			return err
+		}
+		if err := refreshCentroids(tx, touched...); err != nil {
+			return err
+		}
+
+		if dryRun {
+			return errTaxonomyDryRun
+		}
+		return nil
+	})
+
+	if errors.Is(err, errTaxonomyDryRun) {
+		result.MissingEmbeddings = nil // Nothing was created
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// importTaxonomyTag upserts one tag with its aliases
+// Returns uuid.Nil if the tag was skipped
+func importTaxonomyTag(tx *gorm.DB, t domain.TaxonomyTag, result *domain.TaxonomyImportResult) (uuid.UUID, error) {
+	// Same keys as ResolveTag / CreateCanonicalTag: a concurrent resolution cannot
+	// claim one of these aliases or this slug between the lookups and the inserts
+	keys := []string{"slug:" + t.Slug}
+	for _, a := range t.Aliases {
+		if normalized := domain.NormalizeText(a.Text); normalized != "" {
+			keys = append(keys, "alias:"+normalized)
+		}
+	}
+	if err := lockTagKeys(tx, keys...); err != nil {
+		return uuid.Nil, err
+	}
+
+	existing, err := findTagBySlugOrHistory(tx, t.Slug)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// Who owns each alias in the target right now?
+	owners := make([]uuid.UUID, len(t.Aliases))
+	freeAliases := 0
+	seen := make(map[string]bool, len(t.Aliases))
+	for i, a := range t.Aliases {
+		normalized := domain.NormalizeText(a.Text)
+		if normalized == "" || seen[normalized] {
+			owners[i] = uuid.Max // Empty or repeated in the file: ignore
+			continue
+		}
+		seen[normalized] = true
+
+		var owner domain.TagAlias
+		err := tx.Omit("Embedding").Where("normalized_text = ?", normalized).First(&owner).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			freeAliases++
+		case err != nil:
+			return uuid.Nil, fmt.Errorf("failed to look up alias '%s': %w", a.Text, err)
+		default:
+			owners[i] = owner.CanonicalTagID
+		}
+	}
+
+	var tag domain.CanonicalTag
+	if existing == nil {
+		// Never create a canonical that no alias resolves to
+		if freeAliases == 0 {
+			result.Conflicts = append(result.Conflicts, domain.TaxonomyConflict{
+				Kind: domain.TaxonomyConflictNoFreeAlias, Slug: t.Slug,
+				Message: "every alias of this tag belongs to another tag in the target",
+			})
+			return uuid.Nil, nil
+		}
+
+		tag = domain.CanonicalTag{Slug: t.Slug, DisplayName: t.DisplayName, IsApproved: t.IsApproved}
+		if err := tx.Omit("Aliases").Create(&tag).Error; err != nil {
+			return uuid.Nil, fmt.Errorf("failed to create tag '%s': %w", t.Slug, err)
+		}
+		result.TagsCreated++
+	} else {
+		tag = *existing
+		if tag.DisplayName != t.DisplayName || tag.IsApproved != t.IsApproved {
+			if err := tx.Model(&tag).Updates(map[string]interface{}{
+				"display_name": t.DisplayName,
+				"is_approved":  t.IsApproved,
+			}).Error; err != nil {
+				return uuid.Nil, fmt.Errorf("failed to update tag '%s': %w", t.Slug, err)
+			}
+			result.TagsUpdated++
+		} else {
+			result.TagsUnchanged++
+		}
+	}
+
+	for i, a := range t.Aliases {
+		switch owners[i] {
+		case uuid.Max:
+			continue
+		case uuid.Nil:
+			if err := createTaxonomyAlias(tx, tag.ID, a, result); err != nil {
+				return uuid.Nil, err
+			}
+		case tag.ID:
+			result.AliasesUnchanged++
+		default:
+			var ownerSlug string
+			if err := tx.Model(&domain.CanonicalTag{}).Where("id = ?", owners[i]).
+				Pluck("slug", &ownerSlug).Error; err != nil {
+				return uuid.Nil, fmt.Errorf("failed to load alias owner: %w", err)
+			}
+			result.Conflicts = append(result.Conflicts, domain.TaxonomyConflict{
+				Kind: domain.TaxonomyConflictAliasElsewhere, Slug: t.Slug, Alias: a.Text, ExistingSlug: ownerSlug,
+				Message: fmt.Sprintf("alias '%s' points to '%s' in the target", a.Text, ownerSlug),
+			})
+		}
+	}
+
+	return tag.ID, nil
+}
+
+// createTaxonomyAlias inserts an imported alias; without a usable embedding it is stored as NULL
+func createTaxonomyAlias(tx *gorm.DB, tagID uuid.UUID, a domain.TaxonomyAlias, result *domain.TaxonomyImportResult) error {
+	language := a.Language
+	if language == "" || language == domain.LanguageUnknown {
+		language = domain.DetectLanguage(a.Text)
+	}
+
+	alias := domain.TagAlias{
+		CanonicalTagID:  tagID,
+		RawText:         a.Text,
+		NormalizedText:  domain.NormalizeText(a.Text),
+		Language:        language,
+		IsReviewed:      a.IsReviewed,
+		SimilarityScore: 1.0,
+	}
+
+	query := tx
+	if len(a.Embedding) == domain.TaxonomyEmbeddingDims {
+		alias.Embedding = pgvector.NewVector(a.Embedding)
+	} else {
+		query = tx.Omit("Embedding")
+	}
+	if err := query.Create(&alias).Error; err != nil {
+		return fmt.Errorf("failed to create alias '%s': %w", a.Text, err)
+	}
+
+	result.AliasesCreated++
+	if len(a.Embedding) != domain.TaxonomyEmbeddingDims {
+		result.MissingEmbeddings = append(result.MissingEmbeddings, domain.AliasText{ID: alias.ID, RawText: alias.RawText})
+	}
+	return nil
+}
+
+// importTaxonomyParent applies the parent of one imported tag
+func importTaxonomyParent(tx *gorm.DB, tagID uuid.UUID, t domain.TaxonomyTag, slugToID map[string]uuid.UUID, result *domain.TaxonomyImportResult) error {
+	var parentID *uuid.UUID
+	if t.ParentSlug != "" {
+		if id, ok := slugToID[t.ParentSlug]; ok {
+			parentID = &id
+		} else {
+			parent, err := findTagBySlugOrHistory(tx, t.ParentSlug)
+			if err != nil {
+				return err
+			}
+			if parent == nil {
+				result.Conflicts = append(result.Conflicts, domain.TaxonomyConflict{
+					Kind: domain.TaxonomyConflictParentNotFound, Slug: t.Slug, ExistingSlug: t.ParentSlug,
+					Message: fmt.Sprintf("parent '%s' not found", t.ParentSlug),
+				})
+				return nil
+			}
+			parentID = &parent.ID
+		}
+	}
+
+	var current domain.CanonicalTag
+	if err := tx.Select("id", "parent_id").First(&current, "id = ?", tagID).Error; err != nil {
+		return fmt.Errorf("failed to load tag '%s': %w", t.Slug, err)
+	}
+	if sameParent(current.ParentID, parentID) {
+		return nil
+	}
+
+	if parentID != nil {
+		cycle, err := isInSubtree(tx, tagID, *parentID)
+		if err != nil {
+			return err
+		}
+		if cycle {
+			result.Conflicts = append(result.Conflicts, domain.TaxonomyConflict{
+				Kind: domain.TaxonomyConflictParentCycle, Slug: t.Slug, ExistingSlug: t.ParentSlug,
+				Message: fmt.Sprintf("'%s' is inside the subtree of '%s' in the target", t.ParentSlug, t.Slug),
+			})
+			return nil
+		}
+	}
+
+	if err := tx.Model(&domain.CanonicalTag{}).Where("id = ?", tagID).Update("parent_id", parentID).Error; err != nil {
+		return fmt.Errorf("failed to set parent of '%s': %w", t.Slug, err)
+	}
+	result.ParentsSet++
+	return nil
+}
+
+// findTagBySlugOrHistory finds a tag by current slug, then by historic slug
+// Returns nil if neither matches
+func findTagBySlugOrHistory(tx *gorm.DB, slug string) (*domain.CanonicalTag, error) {
+	var tag domain.CanonicalTag
+	err := tx.Where("slug = ?", slug).First(&tag).Error
+	if err == nil {
+		return &tag, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to find tag '%s': %w", slug, err)
+	}
+
+	err = tx.Joins("JOIN tag_slug_history h ON h.canonical_tag_id = canonical_tags.id").
+		Where("h.slug = ?", slug).
+		First(&tag).Error
+	if err == nil {
+		return &tag, nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("failed to find tag '%s' in slug history: %w", slug, err)
+}
+
+// SetAliasEmbeddings stores embeddings for aliases (same order as aliasIDs)
+func (r *tagRepository) SetAliasEmbeddings(ctx context.Context, aliasIDs []uuid.UUID, embeddings [][]float32) error {
+	if len(aliasIDs) != len(embeddings) {
+		return fmt.Errorf("got %d embeddings for %d aliases", len(embeddings), len(aliasIDs))
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, id := range aliasIDs {
+			if err := tx.Model(&domain.TagAlias{}).
+				Where("id = ?", id).
+				Update("embedding", pgvector.NewVector(embeddings[i])).Error; err != nil {
+				return fmt.Errorf("failed to store embedding: %w", err)
+			}
+		}
+		return refreshAliasCentroids(tx, aliasIDs)
+	})
+}
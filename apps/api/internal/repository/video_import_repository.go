@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ============================================================
+// Video Bulk Import Implementation
+// ============================================================
+
+// CreateImportJob stores a new import job
+func (r *videoRepository) CreateImportJob(ctx context.Context, job *domain.VideoImportJob) error {
+	if err := r.db.WithContext(ctx).Create(job).Error; err != nil {
+		return fmt.Errorf("failed to create import job: %w", err)
+	}
+	return nil
+}
+
+// SaveImportJobProgress writes the status, counters and errors of a running job
+func (r *videoRepository) SaveImportJobProgress(ctx context.Context, job *domain.VideoImportJob) error {
+	if err := r.db.WithContext(ctx).Model(job).
+		Select("Status", "Total", "Found", "Created", "Skipped", "Failed", "Errors", "Error", "StartedAt", "FinishedAt").
+		Updates(job).Error; err != nil {
+		return fmt.Errorf("failed to save import job progress: %w", err)
+	}
+	return nil
+}
+
+// GetImportJob returns an import job by ID
+func (r *videoRepository) GetImportJob(ctx context.Context, id uuid.UUID) (*domain.VideoImportJob, error) {
+	var job domain.VideoImportJob
+	if err := r.db.WithContext(ctx).First(&job, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrImportJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get import job: %w", err)
+	}
+	return &job, nil
+}
+
+// ListImportJobs returns import jobs, newest first
+func (r *videoRepository) ListImportJobs(ctx context.Context, page, limit int) ([]domain.VideoImportJob, int64, error) {
+	var jobs []domain.VideoImportJob
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&domain.VideoImportJob{})
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count import jobs: %w", err)
+	}
+	if err := query.Order("created_at DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&jobs).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list import jobs: %w", err)
+	}
+	return jobs, total, nil
+}
+
+// FailInterruptedImportJobs marks jobs left queued/running by a previous process as failed
+func (r *videoRepository) FailInterruptedImportJobs(ctx context.Context) (int64, error) {
+	now := time.Now()
+	result := r.db.WithContext(ctx).Model(&domain.VideoImportJob{}).
+		Where("status IN ?", []domain.VideoImportStatus{domain.VideoImportQueued, domain.VideoImportRunning}).
+		Updates(map[string]interface{}{
+			"status":      domain.VideoImportFailed,
+			"error":       "interrupted by server restart",
+			"finished_at": now,
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to fail interrupted import jobs: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// GetExistingYoutubeIDs returns which of the given YouTube IDs already have a video row
+// Soft-deleted videos count as existing: the youtube_id unique index still holds them
+func (r *videoRepository) GetExistingYoutubeIDs(ctx context.Context, youtubeIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool, len(youtubeIDs))
+	if len(youtubeIDs) == 0 {
+		return existing, nil
+	}
+
+	var found []string
+	if err := r.db.WithContext(ctx).Unscoped().
+		Model(&domain.Video{}).
+		Where("youtube_id IN ?", youtubeIDs).
+		Pluck("youtube_id", &found).Error; err != nil {
+		return nil, fmt.Errorf("failed to check existing videos: %w", err)
+	}
+	for _, id := range found {
+		existing[id] = true
+	}
+	return existing, nil
+}
+
+// CreateVideoIfAbsent inserts a video unless its YouTube ID exists (e.g. created concurrently)
+// Returns false when the video was skipped
+func (r *videoRepository) CreateVideoIfAbsent(ctx context.Context, video *domain.Video) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "youtube_id"}}, DoNothing: true}).
+		Create(video)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to create video: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ============================================================
+// Video Metadata Refresh Implementation
+// ============================================================
+
+// GetVideosForMetadataRefresh returns videos whose metadata is missing or older than staleBefore
+// Placeholder titles come first, then never-refreshed videos, then the oldest refresh
+func (r *videoRepository) GetVideosForMetadataRefresh(ctx context.Context, staleBefore time.Time, limit int) ([]domain.Video, error) {
+	var videos []domain.Video
+	if err := r.db.WithContext(ctx).
+		Select("id", "youtube_id", "title").
+		Where("metadata_refreshed_at IS NULL OR metadata_refreshed_at < ?", staleBefore).
+		Order(gorm.Expr("title LIKE ? DESC", "%"+domain.VideoPlaceholderTitleSuffix)).
+		Order("metadata_refreshed_at ASC NULLS FIRST").
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&videos).Error; err != nil {
+		return nil, fmt.Errorf("failed to get videos for metadata refresh: %w", err)
+	}
+	return videos, nil
+}
+
+// ApplyMetadataRefresh saves refreshed metadata and marks videos YouTube no longer serves, in one transaction
+// Fields in a video's ManualFields (edited by a mod) are kept, checked in SQL so a concurrent edit wins
+func (r *videoRepository) ApplyMetadataRefresh(ctx context.Context, refreshed []domain.Video, unavailableIDs []uuid.UUID, refreshedAt time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, video := range refreshed {
+			updates := map[string]interface{}{
+				"title":                  unlessManual(domain.VideoFieldTitle, video.Title),
+				"published_at":           unlessManual(domain.VideoFieldPublishedAt, video.PublishedAt),
+				"duration":               unlessManual(domain.VideoFieldDuration, video.Duration),
+				"view_count":             video.ViewCount,
+				"thumbnail_url":          unlessManual(domain.VideoFieldThumbnailURL, video.ThumbnailURL),
+				"description":            unlessManual(domain.VideoFieldDescription, video.Description),
+				"channel_id":             video.ChannelID,
+				"channel_title":          video.ChannelTitle,
+				"default_audio_language": video.DefaultAudioLanguage,
+				"category_id":            video.CategoryID,
+				"has_captions":           video.HasCaptions,
+				"availability":           domain.VideoAvailable,
+				"unavailable_since":      nil,
+				"metadata_refreshed_at":  refreshedAt,
+			}
+
+			// UpdateColumns: a refresh is not an edit, updated_at (used for edit conflicts) stays
+			if err := tx.Model(&domain.Video{}).Where("id = ?", video.ID).UpdateColumns(updates).Error; err != nil {
+				return fmt.Errorf("failed to update metadata of video %s: %w", video.YoutubeID, err)
+			}
+		}
+
+		if len(unavailableIDs) > 0 {
+			// Keep the first time the video went missing
+			if err := tx.Model(&domain.Video{}).Where("id IN ?", unavailableIDs).UpdateColumns(map[string]interface{}{
+				"availability":          domain.VideoUnavailable,
+				"unavailable_since":     gorm.Expr("COALESCE(unavailable_since, ?)", refreshedAt),
+				"metadata_refreshed_at": refreshedAt,
+			}).Error; err != nil {
+				return fmt.Errorf("failed to mark unavailable videos: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// unlessManual sets a column to value unless a mod locked it (field is in manual_fields)
+func unlessManual(field string, value interface{}) clause.Expr {
+	return gorm.Expr(fmt.Sprintf("CASE WHEN manual_fields @> ?::jsonb THEN %s ELSE ? END", field), fmt.Sprintf(`["%s"]`, field), value)
+}
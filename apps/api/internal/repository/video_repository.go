@@ -3,7 +3,10 @@ package repository
 import (
 	"api/internal/domain"
 	"api/internal/dto"
+	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -24,13 +27,13 @@ func (r *videoRepository) GetVideoList(req dto.ListVideoRequest) ([]domain.Video
 
 	query := r.db.Model(&domain.Video{}).Preload("CanonicalTags")
 
-	// Apply search query if provided (searches in Title OR Tag Name)
+	// Apply search query if provided (searches in Title, Description OR Tag Name)
 	if req.Q != "" {
 		searchQuery := "%" + req.Q + "%"
 		// LEFT JOIN to canonical_tags for tag name search
 		query = query.Joins("LEFT JOIN video_canonical_tags vct ON vct.video_id = videos.id").
 			Joins("LEFT JOIN canonical_tags ct ON ct.id = vct.canonical_tag_id").
-			Where("LOWER(videos.title) LIKE LOWER(?) OR LOWER(ct.display_name) LIKE LOWER(?)", searchQuery, searchQuery).
+			Where("LOWER(videos.title) LIKE LOWER(?) OR LOWER(videos.description) LIKE LOWER(?) OR LOWER(ct.display_name) LIKE LOWER(?)", searchQuery, searchQuery, searchQuery).
 			Group("videos.id") // Prevent duplicates when video matches multiple tags
 	}
 
@@ -40,8 +43,14 @@ func (r *videoRepository) GetVideoList(req dto.ListVideoRequest) ([]domain.Video
 		if err != nil {
 			return nil, 0, fmt.Errorf("invalid tag_id format: %w", err)
 		}
-		// If we already have JOINs from search, we need to add another condition
-		if req.Q != "" {
+		// Descendant tags: EXISTS avoids duplicate rows when a video has several tags in the subtree
+		if req.IncludeDescendants {
+			query = query.Where(
+				"EXISTS (SELECT 1 FROM video_canonical_tags vd WHERE vd.video_id = videos.id AND vd.canonical_tag_id IN ("+subtreeTagIDsSQL+"))",
+				tagUUID,
+			)
+		} else if req.Q != "" {
+			// If we already have JOINs from search, we need to add another condition
 			query = query.Where("vct.canonical_tag_id = ?", tagUUID)
 		} else {
 			query = query.Joins("JOIN video_canonical_tags ON video_canonical_tags.video_id = videos.id").
@@ -147,6 +156,19 @@ func (r *videoRepository) GetVideoByYoutubeID(youtubeID string) (*domain.Video,
 	return &video, nil
 }
 
+// FindVideoByYoutubeIDWithDeleted retrieves a video by YouTube ID, including soft-deleted ones
+// Returns nil when no video has this ID
+func (r *videoRepository) FindVideoByYoutubeIDWithDeleted(youtubeID string) (*domain.Video, error) {
+	var videos []domain.Video
+	if err := r.db.Unscoped().Where("youtube_id = ?", youtubeID).Limit(1).Find(&videos).Error; err != nil {
+		return nil, err
+	}
+	if len(videos) == 0 {
+		return nil, nil
+	}
+	return &videos[0], nil
+}
+
 // Create creates a new video
 func (r *videoRepository) Create(video *domain.Video) error {
 	return r.db.Create(video).Error
@@ -157,18 +179,74 @@ func (r *videoRepository) Update(video *domain.Video) error {
 	return r.db.Save(video).Error
 }
 
+// UpdateVideoMetadata applies a mod edit (and the new manual field locks) if the video was not modified since expectedUpdatedAt
+// Returns domain.ErrVideoNotFound or domain.ErrVideoModified when nothing was updated
+func (r *videoRepository) UpdateVideoMetadata(ctx context.Context, id uuid.UUID, expectedUpdatedAt time.Time, updates map[string]interface{}, manualFields []string) error {
+	locks, err := json.Marshal(manualFields)
+	if err != nil {
+		return fmt.Errorf("failed to encode manual fields: %w", err)
+	}
+	updates["manual_fields"] = gorm.Expr("?::jsonb", string(locks)) // Map updates skip the field serializer
+	updates["updated_at"] = time.Now()
+	result := r.db.WithContext(ctx).Model(&domain.Video{}).
+		Where("id = ? AND updated_at = ?", id, expectedUpdatedAt).
+		Updates(updates)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update video: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&domain.Video{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check video: %w", err)
+	}
+	if count == 0 {
+		return domain.ErrVideoNotFound
+	}
+	return domain.ErrVideoModified
+}
+
 // Delete soft deletes a video
+// Tag video counters exclude soft-deleted videos, so they are decremented here
 func (r *videoRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&domain.Video{}, "id = ?", id).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&domain.Video{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return adjustVideoTagCounts(tx, id, -1)
+	})
+}
+
+// Restore undoes the soft delete of a video and counts it again on its tags
+// Returns domain.ErrVideoNotFound when no deleted video has this ID
+func (r *videoRepository) Restore(id uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Model(&domain.Video{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Update("deleted_at", nil)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrVideoNotFound
+		}
+		return adjustVideoTagCounts(tx, id, 1)
+	})
 }
 
-// SearchVideos searches videos by title
+// SearchVideos searches videos by title and description
 func (r *videoRepository) SearchVideos(query string, page, limit int) ([]domain.Video, int64, error) {
 	var videos []domain.Video
 	var total int64
 
 	baseQuery := r.db.Model(&domain.Video{}).
-		Where("LOWER(title) LIKE LOWER(?)", "%"+query+"%")
+		Where("LOWER(title) LIKE LOWER(?) OR LOWER(description) LIKE LOWER(?)", "%"+query+"%", "%"+query+"%")
 
 	if err := baseQuery.Count(&total).Error; err != nil {
 		return nil, 0, err
@@ -302,7 +380,7 @@ func (r *videoRepository) GetModVideoList(offset, limit int, searchQuery, tagIDs
 
 	// Apply search filter
 	if searchQuery != "" {
-		query = query.Where("LOWER(title) LIKE ?", "%"+searchQuery+"%")
+		query = query.Where("LOWER(title) LIKE LOWER(?) OR LOWER(description) LIKE LOWER(?)", "%"+searchQuery+"%", "%"+searchQuery+"%")
 	}
 
 	// Apply has_transcript filter
@@ -324,7 +402,7 @@ func (r *videoRepository) GetModVideoList(offset, limit int, searchQuery, tagIDs
 	countQuery := r.db.Model(&domain.Video{})
 
 	if searchQuery != "" {
-		countQuery = countQuery.Where("LOWER(title) LIKE ?", "%"+searchQuery+"%")
+		countQuery = countQuery.Where("LOWER(title) LIKE LOWER(?) OR LOWER(description) LIKE LOWER(?)", "%"+searchQuery+"%", "%"+searchQuery+"%")
 	}
 
 	if hasTranscriptStr == "true" {
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"api/internal/domain"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ============================================================
+// Video Trash (soft-deleted videos) Implementation
+// ============================================================
+
+// ListDeletedVideos returns soft-deleted videos, most recently deleted first
+func (r *videoRepository) ListDeletedVideos(ctx context.Context, page, limit int) ([]domain.Video, int64, error) {
+	var videos []domain.Video
+	var total int64
+
+	query := r.db.WithContext(ctx).Unscoped().Model(&domain.Video{}).Where("deleted_at IS NOT NULL")
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count deleted videos: %w", err)
+	}
+	if err := query.Order("deleted_at DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&videos).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list deleted videos: %w", err)
+	}
+	return videos, total, nil
+}
+
+// GetVideoIDsDeletedBefore returns up to limit soft-deleted videos deleted before the given time, oldest first
+func (r *videoRepository) GetVideoIDsDeletedBefore(ctx context.Context, before time.Time, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := r.db.WithContext(ctx).Unscoped().Model(&domain.Video{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Order("deleted_at ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to get expired deleted videos: %w", err)
+	}
+	return ids, nil
+}
+
+// PurgeVideos permanently deletes soft-deleted videos with their segments, tag links, reviews and proposals
+// Live videos in ids are ignored; returns the number of videos purged
+func (r *videoRepository) PurgeVideos(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	var purged int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var trashed []uuid.UUID
+		if err := tx.Unscoped().Model(&domain.Video{}).
+			Where("id IN ? AND deleted_at IS NOT NULL", ids).
+			Clauses(clause.Locking{Strength: "UPDATE"}). // Not restored concurrently
+			Pluck("id", &trashed).Error; err != nil {
+			return fmt.Errorf("failed to lock deleted videos: %w", err)
+		}
+		if len(trashed) == 0 {
+			return nil
+		}
+
+		// Tags whose usage counters include these videos
+		var tagIDs []uuid.UUID
+		if err := tx.Table("video_canonical_tags").
+			Where("video_id IN ?", trashed).
+			Distinct().
+			Pluck("canonical_tag_id", &tagIDs).Error; err != nil {
+			return fmt.Errorf("failed to get tags of deleted videos: %w", err)
+		}
+
+		for _, table := range []string{
+			"video_canonical_tags",
+			"tag_merge_log_videos", // Undoing a merge must not re-link a purged video
+			"transcript_segments",
+			"video_transcript_reviews",
+			"tag_proposals",
+		} {
+			if err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE video_id IN ?", table), trashed).Error; err != nil {
+				return fmt.Errorf("failed to purge %s: %w", table, err)
+			}
+		}
+
+		if err := recountTags(tx, tagIDs...); err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Where("id IN ?", trashed).Delete(&domain.Video{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to purge videos: %w", result.Error)
+		}
+		purged = result.RowsAffected
+		return nil
+	})
+	return purged, err
+}
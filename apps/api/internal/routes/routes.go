@@ -52,6 +52,7 @@ func RegisterRoutes(
 				authProtected.PATCH("/me", authHandler.UpdateMe)
 				authProtected.GET("/sessions", authHandler.GetActiveSessions)
 				authProtected.POST("/logout-all", authHandler.LogoutAll)
+				authProtected.GET("/me/tag-proposals", tagHandler.ListMyTagProposals) // Own tag proposal history
 			}
 		}
 
@@ -69,6 +70,7 @@ func RegisterRoutes(
 
 		// Video endpoints (public)
 		videos := v1.Group("/videos")
+		videos.Use(middleware.Locale()) // Tag names in ?lang / Accept-Language
 		{
 			videos.GET("", videoHandler.GetVideoList)
 			videos.GET("/:id", videoHandler.GetVideoDetail)
@@ -82,6 +84,13 @@ func RegisterRoutes(
 				videoReviews.GET("/stats", reviewHandler.GetVideoReviewStats)    // Get review count
 				videoReviews.GET("/status", reviewHandler.CheckUserReviewStatus) // Check if user reviewed
 			}
+
+			// Tag proposals (protected - any signed-in user, linked only after mod approval)
+			videoTagProposals := videos.Group("/:id/tag-proposals")
+			videoTagProposals.Use(middleware.AuthMiddleware(userRepo))
+			{
+				videoTagProposals.POST("", tagHandler.ProposeVideoTag)
+			}
 		}
 
 		// Transcript segment endpoints (protected - for mods/admins)
@@ -101,9 +110,13 @@ func RegisterRoutes(
 
 		// Tags endpoints (public - for tag navigation)
 		tags := v1.Group("/tags")
+		tags.Use(middleware.Locale()) // Tag names in ?lang / Accept-Language
 		{
-			tags.GET("", tagHandler.ListCanonicalTags)   // List all tags
-			tags.GET("/:id", tagHandler.GetCanonicalTag) // Get tag by ID
+			tags.GET("", tagHandler.ListCanonicalTags)          // List all tags (?tree=true for topic tree)
+			tags.GET("/trending", tagHandler.GetTrendingTags)   // Most tagged in the last N days
+			tags.GET("/by-slug/:slug", tagHandler.GetTagBySlug) // Get tag by current or historic slug
+			tags.GET("/:id", tagHandler.GetCanonicalTag)        // Get tag by ID
+			tags.GET("/:id/related", tagHandler.GetRelatedTags) // "See also" tags
 		}
 
 		// Admin endpoints - requires admin role
@@ -113,6 +126,11 @@ func RegisterRoutes(
 		{
 			// Statistics
 			admin.GET("/stats", statsHandler.GetAdminStats)
+
+			// Video trash (soft-deleted videos)
+			admin.GET("/videos/trash", videoHandler.ListTrashedVideos)
+			admin.POST("/videos/trash/:id/restore", videoHandler.RestoreVideo) // Same as /mod/videos/:id/restore
+			admin.DELETE("/videos/trash/:id", videoHandler.PurgeVideo)         // Permanent, frees the YouTube ID
 		}
 
 		// Mod endpoints - requires mod or admin role
@@ -131,18 +149,44 @@ func RegisterRoutes(
 			{
 				modVideos.GET("", videoHandler.GetModVideoList)
 				modVideos.GET("/search", videoHandler.SearchVideos)
+				modVideos.GET("/preview", videoHandler.PreviewVideo) // ?url= for full YouTube URLs
 				modVideos.GET("/preview/:id", videoHandler.PreviewVideo)
 				modVideos.POST("", videoHandler.CreateVideo)
+				modVideos.PATCH("/:id", videoHandler.UpdateVideo)
 				modVideos.DELETE("/:id", videoHandler.DeleteVideo)
+				modVideos.POST("/:id/restore", videoHandler.RestoreVideo)
 				// Legacy Tag V1 routes removed - use /api/v2/mod/videos/:id/tags
 				modVideos.POST("/:id/transcript/segments", videoHandler.CreateSegment)
 			}
+
+			// Bulk video import from YouTube (background jobs)
+			modImports := mod.Group("/imports")
+			{
+				modImports.POST("", videoHandler.CreateVideoImport)
+				modImports.GET("", videoHandler.ListVideoImports)
+				modImports.GET("/:id", videoHandler.GetVideoImport)
+			}
 		}
 	}
 
 	// API v2 group - Canonical-Alias Tag Architecture
 	v2 := router.Group("/api/v2")
 	{
+		// Admin endpoints - requires admin role
+		admin := v2.Group("/admin")
+		admin.Use(middleware.AuthMiddleware(userRepo))
+		admin.Use(middleware.RequireAdmin())
+		{
+			// Tag taxonomy (move tags between environments)
+			admin.GET("/tags/export", tagHandler.ExportTaxonomy)  // Versioned JSON (?include_embeddings=true)
+			admin.POST("/tags/import", tagHandler.ImportTaxonomy) // Upsert by slug/alias (?dry_run=true)
+
+			// Tag resolution policy (thresholds and behaviour of ResolveTag, audited)
+			admin.GET("/tags/resolution-policy", tagHandler.GetResolutionPolicy)
+			admin.PATCH("/tags/resolution-policy", tagHandler.UpdateResolutionPolicy)
+			admin.GET("/tags/resolution-policy/changes", tagHandler.ListResolutionPolicyChanges)
+		}
+
 		// Mod endpoints - requires mod or admin role
 		mod := v2.Group("/mod")
 		mod.Use(middleware.AuthMiddleware(userRepo))
@@ -154,14 +198,53 @@ func RegisterRoutes(
 				modTags.GET("", tagHandler.ListCanonicalTags)               // List all canonical tags
 				modTags.POST("", tagHandler.CreateCanonicalTag)             // Create with auto-resolution
 				modTags.POST("/merge", tagHandler.MergeTags)                // Manually merge source into target
+				modTags.POST("/merges/:id/undo", tagHandler.UndoMerge)      // Undo a merge (restore source tag)
+				modTags.POST("/resolve", tagHandler.ResolveTag)             // Resolve (?dry_run=true explains without writing)
+				modTags.POST("/resolve-batch", tagHandler.ResolveTagBatch)  // Resolve many names (optionally tag one video)
 				modTags.GET("/search", tagHandler.SearchCanonicalTags)      // Search canonical tags
 				modTags.GET("/:id", tagHandler.GetCanonicalTag)             // Get by ID
 				modTags.PATCH("/:id/approve", tagHandler.UpdateTagApproval) // Update approval status
+				modTags.PATCH("/:id/parent", tagHandler.SetTagParent)       // Move tag (with subtree) in the topic tree
+				modTags.POST("/:id/rename", tagHandler.RenameTag)           // Rename (new slug, old slug + name kept)
+				modTags.DELETE("/:id", tagHandler.DeleteCanonicalTag)       // Delete (?reassign_to=ID merges instead of unlinking)
+				modTags.GET("/:id/aliases", tagHandler.ListTagAliases)      // List aliases with scores
+
+				// Localized display names
+				modTags.PUT("/:id/display-names", tagHandler.PromoteAliasDisplayName)         // Promote alias to a locale's name
+				modTags.DELETE("/:id/display-names/:locale", tagHandler.DeleteTagDisplayName) // Back to default name for locale
+
+				// Alias management
+				modTags.POST("/aliases/:alias_id/move", tagHandler.MoveAlias)            // Move alias to another canonical
+				modTags.POST("/aliases/:alias_id/split", tagHandler.SplitAlias)          // Split alias into new canonical
+				modTags.PATCH("/aliases/:alias_id/review", tagHandler.UpdateAliasReview) // Set is_reviewed
+				modTags.DELETE("/aliases/:alias_id", tagHandler.DeleteAlias)             // Delete alias (not the last one)
+
+				// Review queue for AI auto-merged aliases
+				modTags.GET("/review-queue", tagHandler.GetAliasReviewQueue)                     // Unreviewed aliases, lowest similarity first
+				modTags.GET("/review-queue/stats", tagHandler.GetAliasReviewStats)               // Per-mod throughput
+				modTags.POST("/review-queue/:alias_id/accept", tagHandler.AcceptQueuedAlias)     // Keep on current canonical
+				modTags.POST("/review-queue/:alias_id/reassign", tagHandler.ReassignQueuedAlias) // Move to another canonical
+				modTags.POST("/review-queue/:alias_id/split", tagHandler.SplitQueuedAlias)       // Split into new canonical
+
+				// Duplicate detection suggestions
+				modTags.GET("/duplicates", tagHandler.ListDuplicateTags)                // Pending duplicate pairs, highest score first
+				modTags.POST("/duplicates/scan", tagHandler.ScanDuplicateTags)          // Run detection job now
+				modTags.POST("/duplicates/:id/accept", tagHandler.AcceptDuplicateTag)   // Merge pair via MergeTags
+				modTags.POST("/duplicates/:id/dismiss", tagHandler.DismissDuplicateTag) // Never suggest this pair again
+
+				// Related tags
+				modTags.POST("/related/refresh", tagHandler.RefreshRelatedTags) // Run related tags job now
+
+				// Tag proposals from regular users
+				modTags.GET("/proposals", tagHandler.ListTagProposals)                // Queue (?status=pending|approved|rejected|all)
+				modTags.POST("/proposals/:id/approve", tagHandler.ApproveTagProposal) // Link tag to video
+				modTags.POST("/proposals/:id/reject", tagHandler.RejectTagProposal)   // Close with reason
 			}
 
 			// Video-Tag management (v2 - uses canonical tags)
 			modVideos := mod.Group("/videos")
 			{
+				modVideos.POST("/tags/bulk", tagHandler.BulkTagVideos)                        // Apply tags to many videos
 				modVideos.GET("/:id/tags", tagHandler.GetVideoCanonicalTags)                  // Get video's canonical tags
 				modVideos.POST("/:id/tags", tagHandler.AddCanonicalTagToVideo)                // Add tag with auto-resolution
 				modVideos.DELETE("/:id/tags/:tag_id", tagHandler.RemoveCanonicalTagFromVideo) // Remove canonical tag
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"api/internal/database"
 	"api/internal/handler"
 	"api/internal/infrastructure"
+	"api/internal/job"
 	"api/internal/repository"
 	"api/internal/routes"
 	"api/internal/service"
@@ -57,6 +59,12 @@ func NewServer() *http.Server {
 		openAIClient = nil // Continue without AI features
 	}
 
+	youtubeClient, err := infrastructure.NewYouTubeClient()
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to initialize YouTube client - channel/playlist imports will be disabled")
+		youtubeClient = nil
+	}
+
 	// Repository layer
 	videoRepo := repository.NewVideoRepository(dbService.GetGormDB())
 	userRepo := repository.NewUserRepository(dbService.GetGormDB())
@@ -66,8 +74,11 @@ func NewServer() *http.Server {
 	statsRepo := repository.NewStatsRepository(dbService.GetGormDB())
 	reviewRepo := repository.NewVideoTranscriptReviewRepository(dbService.GetGormDB())
 
+	// Background jobs and imports run until server shutdown (stopJobs is registered below)
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+
 	// Service layer
-	videoService := service.NewVideoService(videoRepo)
+	videoService := service.NewVideoService(jobCtx, videoRepo, youtubeClient)
 	userService := service.NewUserService(userRepo)
 	authService := service.NewAuthService(userRepo, socialAccountRepo, sessionRepo)
 	tagService := service.NewTagService(tagRepo, videoRepo)
@@ -100,6 +111,37 @@ func NewServer() *http.Server {
 		WriteTimeout: 30 * time.Second,
 	}
 
+	// Background jobs (stopped on server shutdown)
+	server.RegisterOnShutdown(stopJobs)
+
+	if err := videoService.FailInterruptedVideoImports(jobCtx); err != nil {
+		log.Warn().Err(err).Msg("Failed to clean up interrupted video imports")
+	}
+
+	job.RunPeriodic(jobCtx, "tag-duplicate-detection", job.IntervalFromEnv("TAG_DUPLICATE_SCAN_INTERVAL", 6*time.Hour), func(ctx context.Context) error {
+		_, err := tagServiceV2.DetectDuplicateTags(ctx)
+		return err
+	})
+
+	job.RunPeriodic(jobCtx, "tag-related-refresh", job.IntervalFromEnv("TAG_RELATED_REFRESH_INTERVAL", 6*time.Hour), func(ctx context.Context) error {
+		_, err := tagServiceV2.RefreshRelatedTags(ctx)
+		return err
+	})
+
+	trashRetentionDays, _ := strconv.Atoi(os.Getenv("VIDEO_TRASH_RETENTION_DAYS"))
+	if trashRetentionDays <= 0 {
+		trashRetentionDays = 30
+	}
+	job.RunPeriodic(jobCtx, "video-trash-purge", job.IntervalFromEnv("VIDEO_TRASH_PURGE_INTERVAL", 24*time.Hour), func(ctx context.Context) error {
+		_, err := videoService.PurgeExpiredVideos(ctx, time.Duration(trashRetentionDays)*24*time.Hour)
+		return err
+	})
+
+	job.RunPeriodic(jobCtx, "video-metadata-refresh", job.IntervalFromEnv("VIDEO_METADATA_REFRESH_INTERVAL", time.Hour), func(ctx context.Context) error {
+		_, err := videoService.RefreshVideoMetadata(ctx)
+		return err
+	})
+
 	log.Info().Msgf("Server starting on port %d", port)
 	return server
 }
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ============================================================
+// Alias Management Implementation
+// ============================================================
+
+// ListTagAliases returns all aliases of a canonical tag with review metadata
+func (s *tagServiceV2) ListTagAliases(ctx context.Context, tagID string) ([]dto.TagAliasResponse, error) {
+	tagUUID, err := uuid.Parse(tagID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+	}
+
+	if _, err := s.tagRepo.GetCanonicalByID(ctx, tagUUID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrCanonicalTagNotFound, err)
+	}
+
+	aliases, err := s.tagRepo.ListAliasesByCanonicalID(ctx, tagUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list aliases: %w", err)
+	}
+
+	responses := make([]dto.TagAliasResponse, len(aliases))
+	for i, alias := range aliases {
+		responses[i] = toTagAliasResponse(&alias)
+	}
+
+	return responses, nil
+}
+
+// MoveAlias moves an alias to another canonical tag
+// Business logic:
+// 1. Alias and target must exist
+// 2. Target must differ from current canonical
+// 3. Source canonical must keep at least one alias (use MergeTags otherwise)
+func (s *tagServiceV2) MoveAlias(ctx context.Context, aliasID string, req dto.MoveAliasRequest) (*dto.TagAliasResponse, error) {
+	return s.moveAlias(ctx, aliasID, req, nil)
+}
+
+// moveAlias implements MoveAlias; review is recorded in the same transaction when not nil
+func (s *tagServiceV2) moveAlias(ctx context.Context, aliasID string, req dto.MoveAliasRequest, review *domain.TagAliasReviewLog) (*dto.TagAliasResponse, error) {
+	aliasUUID, err := uuid.Parse(aliasID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+	}
+	targetUUID, err := uuid.Parse(req.TargetID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+	}
+
+	alias, err := s.tagRepo.GetAliasByID(ctx, aliasUUID)
+	if err != nil {
+		return nil, err
+	}
+	if alias.CanonicalTagID == targetUUID {
+		return nil, fmt.Errorf("%w", domain.ErrSameSourceTarget)
+	}
+
+	if _, err := s.tagRepo.GetCanonicalByID(ctx, targetUUID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrTargetTagNotFound, err)
+	}
+
+	if err := s.tagRepo.MoveAlias(ctx, aliasUUID, targetUUID, review); err != nil {
+		return nil, fmt.Errorf("failed to move alias: %w", err)
+	}
+
+	alias.CanonicalTagID = targetUUID
+	alias.IsReviewed = true
+	response := toTagAliasResponse(alias)
+	return &response, nil
+}
+
+// SplitAlias detaches an alias into its own new canonical tag
+// The new canonical uses req.DisplayName, or the alias raw text if empty
+// Its slug gets a numeric suffix when the plain slug is taken (now or historically)
+func (s *tagServiceV2) SplitAlias(ctx context.Context, aliasID string, req dto.SplitAliasRequest) (*dto.TagResponse, error) {
+	return s.splitAlias(ctx, aliasID, req, nil)
+}
+
+// splitAlias implements SplitAlias; review is recorded in the same transaction when not nil
+func (s *tagServiceV2) splitAlias(ctx context.Context, aliasID string, req dto.SplitAliasRequest, review *domain.TagAliasReviewLog) (*dto.TagResponse, error) {
+	aliasUUID, err := uuid.Parse(aliasID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+	}
+
+	alias, err := s.tagRepo.GetAliasByID(ctx, aliasUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	displayName := strings.TrimSpace(req.DisplayName)
+	if displayName == "" {
+		displayName = alias.RawText
+	}
+
+	canonical, err := domain.NewCanonicalTag(displayName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+	}
+
+	if err := s.tagRepo.SplitAlias(ctx, aliasUUID, canonical, review); err != nil {
+		return nil, fmt.Errorf("failed to split alias: %w", err)
+	}
+
+	alias.CanonicalTagID = canonical.ID
+	canonical.Aliases = []domain.TagAlias{*alias}
+	return s.toCanonicalTagResponse(canonical), nil
+}
+
+// DeleteAlias deletes an alias (refused if it is the last one of its canonical)
+func (s *tagServiceV2) DeleteAlias(ctx context.Context, aliasID string) error {
+	aliasUUID, err := uuid.Parse(aliasID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+	}
+
+	if err := s.tagRepo.DeleteAlias(ctx, aliasUUID); err != nil {
+		return fmt.Errorf("failed to delete alias: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateAliasReview marks an alias as reviewed or not
+// Marking it reviewed is an accept: reviewer info and the review log are recorded like in the review queue
+func (s *tagServiceV2) UpdateAliasReview(ctx context.Context, aliasID string, reviewerID uuid.UUID, isReviewed bool) (*dto.TagAliasResponse, error) {
+	if isReviewed {
+		return s.AcceptAlias(ctx, aliasID, reviewerID)
+	}
+
+	aliasUUID, err := uuid.Parse(aliasID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+	}
+
+	if err := s.tagRepo.ReopenAliasReview(ctx, aliasUUID); err != nil {
+		return nil, fmt.Errorf("failed to update alias review: %w", err)
+	}
+
+	alias, err := s.tagRepo.GetAliasByID(ctx, aliasUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toTagAliasResponse(alias)
+	return &response, nil
+}
+
+// toTagAliasResponse converts domain.TagAlias to dto.TagAliasResponse
+func toTagAliasResponse(alias *domain.TagAlias) dto.TagAliasResponse {
+	return dto.TagAliasResponse{
+		ID:              alias.ID.String(),
+		CanonicalTagID:  alias.CanonicalTagID.String(),
+		RawText:         alias.RawText,
+		NormalizedText:  alias.NormalizedText,
+		Language:        alias.Language,
+		IsReviewed:      alias.IsReviewed,
+		SimilarityScore: alias.SimilarityScore,
+		CreatedAt:       alias.CreatedAt,
+	}
+}
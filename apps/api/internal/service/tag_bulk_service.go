@@ -0,0 +1,222 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ============================================================
+// Bulk Video Tagging Implementation
+// ============================================================
+
+// resolvedTagInput is the outcome of resolving one distinct tag input
+type resolvedTagInput struct {
+	canonical *domain.CanonicalTag
+	isNew     bool
+	err       error
+}
+
+// BulkTagVideos applies every tag in req.Tags to every video in req.VideoIDs
+func (s *tagServiceV2) BulkTagVideos(ctx context.Context, req dto.BulkVideoTagRequest) (*dto.BulkVideoTagResponse, error) {
+	assignments := make([]dto.VideoTagAssignment, len(req.VideoIDs))
+	for i, videoID := range req.VideoIDs {
+		assignments[i] = dto.VideoTagAssignment{VideoID: videoID, Tags: req.Tags}
+	}
+	return s.ImportVideoTags(ctx, assignments)
+}
+
+// ImportVideoTags applies per-video tag lists
+// Each distinct tag input is resolved once (names embedded with one BatchGetEmbeddings call),
+// then all links are inserted together. Failures are reported per item, never for the whole run.
+func (s *tagServiceV2) ImportVideoTags(ctx context.Context, assignments []dto.VideoTagAssignment) (*dto.BulkVideoTagResponse, error) {
+	// 1. Resolve distinct tag inputs
+	var inputs []string
+	seenInputs := make(map[string]bool)
+	for _, a := range assignments {
+		for _, input := range a.Tags {
+			key := tagInputKey(input)
+			if key == "" || seenInputs[key] {
+				continue
+			}
+			seenInputs[key] = true
+			inputs = append(inputs, input)
+		}
+	}
+	resolved := s.resolveTagInputs(ctx, inputs)
+
+	response := &dto.BulkVideoTagResponse{
+		Tags:  make([]dto.BulkTagResolution, 0, len(inputs)),
+		Items: []dto.BulkVideoTagItem{},
+	}
+	for _, input := range inputs {
+		r := resolved[tagInputKey(input)]
+		resolution := dto.BulkTagResolution{Input: input, IsNew: r.isNew}
+		if r.err != nil {
+			resolution.Error = r.err.Error()
+		} else {
+			resolution.Tag = s.toCanonicalTagResponse(r.canonical)
+		}
+		response.Tags = append(response.Tags, resolution)
+	}
+
+	// 2. Verify videos (once per distinct ID)
+	videoErrs := make(map[string]error)
+	for _, a := range assignments {
+		if _, checked := videoErrs[a.VideoID]; checked {
+			continue
+		}
+		videoErrs[a.VideoID] = s.checkVideoExists(a.VideoID)
+	}
+
+	// 3. Build links and insert them in one go
+	var links []domain.VideoTagLink
+	for _, a := range assignments {
+		if videoErrs[a.VideoID] != nil {
+			continue
+		}
+		videoUUID := uuid.MustParse(a.VideoID) // Validated by checkVideoExists
+		for _, input := range a.Tags {
+			if r, ok := resolved[tagInputKey(input)]; ok && r.err == nil {
+				links = append(links, domain.VideoTagLink{VideoID: videoUUID, CanonicalTagID: r.canonical.ID})
+			}
+		}
+	}
+
+	created := make(map[domain.VideoTagLink]bool)
+	if len(links) > 0 {
+		inserted, err := s.tagRepo.AddCanonicalTagsToVideos(ctx, links)
+		if err != nil {
+			return nil, fmt.Errorf("failed to link tags to videos: %w", err)
+		}
+		for _, link := range inserted {
+			created[link] = true
+		}
+	}
+
+	// 4. Per-item results (the first input claiming a created link reports "added")
+	for _, a := range assignments {
+		for _, input := range a.Tags {
+			key := tagInputKey(input)
+			if key == "" {
+				continue
+			}
+			item := dto.BulkVideoTagItem{VideoID: a.VideoID, Input: input}
+			r := resolved[key]
+
+			switch {
+			case videoErrs[a.VideoID] != nil:
+				item.Status = dto.BulkTagStatusFailed
+				item.Error = videoErrs[a.VideoID].Error()
+			case r.err != nil:
+				item.Status = dto.BulkTagStatusFailed
+				item.Error = r.err.Error()
+			default:
+				item.TagID = r.canonical.ID.String()
+				link := domain.VideoTagLink{VideoID: uuid.MustParse(a.VideoID), CanonicalTagID: r.canonical.ID}
+				if created[link] {
+					item.Status = dto.BulkTagStatusAdded
+					delete(created, link)
+				} else {
+					item.Status = dto.BulkTagStatusAlreadyTagged
+				}
+			}
+
+			switch item.Status {
+			case dto.BulkTagStatusAdded:
+				response.AddedCount++
+			case dto.BulkTagStatusAlreadyTagged:
+				response.SkippedCount++
+			default:
+				response.FailedCount++
+			}
+			response.Items = append(response.Items, item)
+		}
+	}
+
+	return response, nil
+}
+
+// resolveTagInputs resolves tag IDs and names, keyed by tagInputKey
+// Names missing from Layer 1 are embedded together, then resolved one by one with those embeddings
+func (s *tagServiceV2) resolveTagInputs(ctx context.Context, inputs []string) map[string]resolvedTagInput {
+	resolved := make(map[string]resolvedTagInput, len(inputs))
+
+	var misses []string
+	for _, input := range inputs {
+		key := tagInputKey(input)
+
+		if tagUUID, err := uuid.Parse(input); err == nil {
+			canonical, err := s.tagRepo.GetCanonicalByID(ctx, tagUUID)
+			if err != nil {
+				resolved[key] = resolvedTagInput{err: fmt.Errorf("%w: %s", domain.ErrCanonicalTagNotFound, input)}
+				continue
+			}
+			resolved[key] = resolvedTagInput{canonical: canonical}
+			continue
+		}
+
+		// Layer 1 (exact alias) needs no embedding
+		canonical, err := s.tagRepo.GetCanonicalByAlias(ctx, key)
+		if err != nil {
+			resolved[key] = resolvedTagInput{err: fmt.Errorf("failed to look up tag: %w", err)}
+			continue
+		}
+		if canonical != nil {
+			resolved[key] = resolvedTagInput{canonical: canonical}
+			continue
+		}
+		misses = append(misses, input)
+	}
+
+	if len(misses) == 0 {
+		return resolved
+	}
+
+	// One embeddings call for all misses; without it resolveTag falls back per input
+	embeddings, err := s.tagRepo.GetEmbeddingsForTexts(ctx, misses)
+	if err != nil {
+		fmt.Printf("[BULK_TAG] ⚠ Batch embedding failed: %v (resolving one by one)\n", err)
+		embeddings = nil
+	}
+
+	for i, input := range misses {
+		var precomputed []float32
+		if embeddings != nil {
+			precomputed = embeddings[i]
+		}
+
+		// Sequential on purpose: a tag created for one input is visible to the next (Layer 3)
+		canonical, _, isNew, err := s.resolveTag(ctx, input, precomputed)
+		if err != nil {
+			resolved[tagInputKey(input)] = resolvedTagInput{err: fmt.Errorf("failed to resolve tag: %w", err)}
+			continue
+		}
+		resolved[tagInputKey(input)] = resolvedTagInput{canonical: canonical, isNew: isNew}
+	}
+
+	return resolved
+}
+
+// checkVideoExists validates a video ID and verifies the video exists
+func (s *tagServiceV2) checkVideoExists(videoID string) error {
+	videoUUID, err := uuid.Parse(videoID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid video ID", domain.ErrInvalidRequest)
+	}
+	if _, err := s.videoRepo.GetVideoByID(videoUUID); err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrVideoNotFound, videoID)
+	}
+	return nil
+}
+
+// tagInputKey identifies equal tag inputs: canonical UUID for IDs, normalized text for names
+func tagInputKey(input string) string {
+	if tagUUID, err := uuid.Parse(input); err == nil {
+		return tagUUID.String()
+	}
+	return domain.NormalizeText(input)
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ============================================================
+// Localized Display Names Implementation
+// ============================================================
+
+// PromoteAliasDisplayName makes an alias of the tag its display name for a locale
+// The locale defaults to the alias language; "unk" aliases need an explicit locale
+func (s *tagServiceV2) PromoteAliasDisplayName(ctx context.Context, tagID string, req dto.PromoteAliasDisplayNameRequest) (*dto.TagResponse, error) {
+	tagUUID, err := uuid.Parse(tagID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid tag ID: %w", domain.ErrInvalidRequest, err)
+	}
+	aliasUUID, err := uuid.Parse(req.AliasID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid alias ID: %w", domain.ErrInvalidRequest, err)
+	}
+
+	canonical, err := s.tagRepo.GetCanonicalByID(ctx, tagUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrCanonicalTagNotFound, err)
+	}
+
+	alias, err := s.tagRepo.GetAliasByID(ctx, aliasUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrAliasNotFound, err)
+	}
+	if alias.CanonicalTagID != canonical.ID {
+		return nil, fmt.Errorf("%w: alias '%s' belongs to another tag", domain.ErrInvalidRequest, alias.RawText)
+	}
+
+	locale := alias.Language
+	if req.Locale != "" {
+		locale = domain.NormalizeLocale(req.Locale)
+		if locale == "" {
+			return nil, fmt.Errorf("%w: invalid locale '%s'", domain.ErrInvalidRequest, req.Locale)
+		}
+	}
+	if locale == "" || locale == domain.LanguageUnknown {
+		return nil, fmt.Errorf("%w: language of alias '%s' is unknown, locale is required", domain.ErrInvalidRequest, alias.RawText)
+	}
+
+	if err := s.tagRepo.SetTagDisplayName(ctx, &domain.TagDisplayName{
+		CanonicalTagID: canonical.ID,
+		Locale:         locale,
+		DisplayName:    alias.RawText,
+		AliasID:        &alias.ID,
+	}); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("[DISPLAY_NAME] ✓ '%s' is now the %s name of '%s'\n", alias.RawText, locale, canonical.DisplayName)
+
+	return s.tagResponseWithDisplayNames(ctx, canonical)
+}
+
+// DeleteTagDisplayName removes the display name of a tag for one locale (falls back to the default)
+func (s *tagServiceV2) DeleteTagDisplayName(ctx context.Context, tagID, locale string) (*dto.TagResponse, error) {
+	tagUUID, err := uuid.Parse(tagID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid tag ID: %w", domain.ErrInvalidRequest, err)
+	}
+	normalized := domain.NormalizeLocale(locale)
+	if normalized == "" {
+		return nil, fmt.Errorf("%w: invalid locale '%s'", domain.ErrInvalidRequest, locale)
+	}
+
+	canonical, err := s.tagRepo.GetCanonicalByID(ctx, tagUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrCanonicalTagNotFound, err)
+	}
+
+	deleted, err := s.tagRepo.DeleteTagDisplayName(ctx, tagUUID, normalized)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, fmt.Errorf("%w: no %s display name", domain.ErrDisplayNameNotFound, normalized)
+	}
+
+	return s.tagResponseWithDisplayNames(ctx, canonical)
+}
+
+// tagResponseWithDisplayNames converts a tag and attaches all its localized names
+func (s *tagServiceV2) tagResponseWithDisplayNames(ctx context.Context, canonical *domain.CanonicalTag) (*dto.TagResponse, error) {
+	names, err := s.tagRepo.GetTagDisplayNames(ctx, []uuid.UUID{canonical.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	response := s.toCanonicalTagResponse(canonical)
+	response.DisplayNames = names[canonical.ID]
+	name, locale := domain.LocalizedDisplayName(names[canonical.ID], domain.LocalesFromContext(ctx), canonical.DisplayName)
+	response.Name, response.Locale = name, locale
+	return response, nil
+}
+
+// localizeTagResponses replaces tag names with the best name for the request locales
+// No-op without locales (mod endpoints); failures keep the default names
+func (s *tagServiceV2) localizeTagResponses(ctx context.Context, tags ...*dto.TagResponse) {
+	locales := domain.LocalesFromContext(ctx)
+	if len(locales) == 0 || len(tags) == 0 {
+		return
+	}
+
+	ids := make([]uuid.UUID, 0, len(tags))
+	for _, tag := range tags {
+		if id, err := uuid.Parse(tag.ID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	names, err := s.tagRepo.GetTagDisplayNames(ctx, ids)
+	if err != nil {
+		fmt.Printf("[DISPLAY_NAME] ⚠ Failed to load display names: %v (using defaults)\n", err)
+		return
+	}
+
+	for _, tag := range tags {
+		id, _ := uuid.Parse(tag.ID)
+		tag.Name, tag.Locale = domain.LocalizedDisplayName(names[id], locales, tag.Name)
+	}
+}
+
+// localizeTagTree localizes the names of every node of a topic tree
+func (s *tagServiceV2) localizeTagTree(ctx context.Context, roots []dto.TagTreeNodeResponse) {
+	locales := domain.LocalesFromContext(ctx)
+	if len(locales) == 0 {
+		return
+	}
+
+	var nodes []*dto.TagTreeNodeResponse
+	var collect func(list []dto.TagTreeNodeResponse)
+	collect = func(list []dto.TagTreeNodeResponse) {
+		for i := range list {
+			nodes = append(nodes, &list[i])
+			collect(list[i].Children)
+		}
+	}
+	collect(roots)
+
+	ids := make([]uuid.UUID, 0, len(nodes))
+	for _, node := range nodes {
+		if id, err := uuid.Parse(node.ID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	names, err := s.tagRepo.GetTagDisplayNames(ctx, ids)
+	if err != nil {
+		fmt.Printf("[DISPLAY_NAME] ⚠ Failed to load display names: %v (using defaults)\n", err)
+		return
+	}
+
+	for _, node := range nodes {
+		id, _ := uuid.Parse(node.ID)
+		node.Name, _ = domain.LocalizedDisplayName(names[id], locales, node.Name)
+	}
+}
+
+// tagResponsePointers returns pointers to the elements of a TagResponse slice (for localization)
+func tagResponsePointers(tags []dto.TagResponse) []*dto.TagResponse {
+	pointers := make([]*dto.TagResponse, len(tags))
+	for i := range tags {
+		pointers[i] = &tags[i]
+	}
+	return pointers
+}
@@ -0,0 +1,269 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Duplicate detection thresholds
+// DUPLICATE_MAX_DISTANCE is looser than the policy's auto-merge distance on purpose:
+// pairs below that distance would have been merged at creation time,
+// the band above it is where humans need to decide.
+const (
+	DUPLICATE_MAX_DISTANCE = 0.55 // ~72% similarity
+	DUPLICATE_MIN_TRIGRAM  = 0.50 // pg_trgm similarity of display names
+	DUPLICATE_MIN_SCORE    = 0.50 // Pairs scoring below this are not suggested
+
+	duplicateVideoSamples = 5
+)
+
+// Score weights (sum to 1)
+const (
+	duplicateWeightEmbedding = 0.6
+	duplicateWeightSlug      = 0.2
+	duplicateWeightTrigram   = 0.2
+)
+
+// ============================================================
+// Duplicate Detection Implementation
+// ============================================================
+
+// DetectDuplicateTags scans canonical tags for likely duplicates and stores candidate pairs
+// Dismissed pairs are never re-suggested; pairs that no longer match are dropped
+func (s *tagServiceV2) DetectDuplicateTags(ctx context.Context) (int, error) {
+	scanStartedAt := time.Now()
+
+	pairs, err := s.tagRepo.FindDuplicatePairs(ctx, DUPLICATE_MAX_DISTANCE, DUPLICATE_MIN_TRIGRAM)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find duplicate pairs: %w", err)
+	}
+
+	candidates := make([]domain.TagDuplicateCandidate, 0, len(pairs))
+	for _, p := range pairs {
+		slugSim := slugSimilarity(p.SlugA, p.SlugB)
+		score := duplicateScore(p.EmbeddingDistance, slugSim, p.TrigramSimilarity)
+		if score < DUPLICATE_MIN_SCORE {
+			continue
+		}
+
+		candidates = append(candidates, domain.TagDuplicateCandidate{
+			TagAID:            p.TagAID,
+			TagBID:            p.TagBID,
+			Status:            domain.DuplicateStatusPending,
+			Source:            domain.DuplicateSourceScan,
+			Score:             score,
+			EmbeddingDistance: p.EmbeddingDistance,
+			SlugSimilarity:    slugSim,
+			TrigramSimilarity: p.TrigramSimilarity,
+			MatchedAliasA:     p.AliasA,
+			MatchedAliasB:     p.AliasB,
+			CoOccurrenceCount: p.CoOccurrenceCount,
+		})
+	}
+
+	if err := s.tagRepo.SaveDuplicateCandidates(ctx, candidates, scanStartedAt); err != nil {
+		return 0, fmt.Errorf("failed to save duplicate candidates: %w", err)
+	}
+
+	return len(candidates), nil
+}
+
+// ListDuplicateCandidates returns pending duplicate pairs with scores and evidence
+func (s *tagServiceV2) ListDuplicateCandidates(ctx context.Context, page, limit int) (*dto.TagDuplicateListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 20
+	}
+
+	candidates, total, err := s.tagRepo.ListDuplicateCandidates(ctx, page, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list duplicate candidates: %w", err)
+	}
+
+	// Load both tags and the video samples with one query per kind (not per candidate)
+	candidateIDs := make([]uint, len(candidates))
+	tagIDs := make([]uuid.UUID, 0, 2*len(candidates))
+	for i, cand := range candidates {
+		candidateIDs[i] = cand.ID
+		tagIDs = append(tagIDs, cand.TagAID, cand.TagBID)
+	}
+
+	tags, err := s.tagRepo.GetCanonicalsByIDs(ctx, tagIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load duplicate tags: %w", err)
+	}
+	tagsByID := make(map[uuid.UUID]*domain.CanonicalTag, len(tags))
+	for i := range tags {
+		tagsByID[tags[i].ID] = &tags[i]
+	}
+
+	videosByCandidate, err := s.tagRepo.GetCoOccurringVideos(ctx, candidateIDs, duplicateVideoSamples)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load co-occurring videos: %w", err)
+	}
+
+	items := make([]dto.TagDuplicateCandidateResponse, 0, len(candidates))
+	for _, cand := range candidates {
+		tagA, okA := tagsByID[cand.TagAID]
+		tagB, okB := tagsByID[cand.TagBID]
+		if !okA || !okB {
+			continue // Merged or deleted since the page was listed
+		}
+
+		items = append(items, dto.TagDuplicateCandidateResponse{
+			ID:                cand.ID,
+			TagA:              *s.toCanonicalTagResponse(tagA),
+			TagB:              *s.toCanonicalTagResponse(tagB),
+			Source:            string(cand.Source),
+			Score:             cand.Score,
+			EmbeddingDistance: cand.EmbeddingDistance,
+			SlugSimilarity:    cand.SlugSimilarity,
+			TrigramSimilarity: cand.TrigramSimilarity,
+			MatchedAliases:    []string{cand.MatchedAliasA, cand.MatchedAliasB},
+			CoOccurrenceCount: cand.CoOccurrenceCount,
+			CoOccurringVideos: toVideoRefResponses(videosByCandidate[cand.ID]),
+			DetectedAt:        cand.UpdatedAt,
+		})
+	}
+
+	return &dto.TagDuplicateListResponse{
+		Data: items,
+		Pagination: dto.PaginationMetadata{
+			Page:       page,
+			Limit:      limit,
+			TotalItems: total,
+			TotalPages: int(math.Ceil(float64(total) / float64(limit))),
+		},
+	}, nil
+}
+
+// AcceptDuplicate merges the pair via MergeTags, which marks the candidate accepted
+// Merge direction: req.TargetID if given, otherwise the approved tag, otherwise the older one
+func (s *tagServiceV2) AcceptDuplicate(ctx context.Context, candidateID string, reviewerID uuid.UUID, req dto.AcceptDuplicateRequest) (*dto.MergeTagsResponse, error) {
+	candidate, err := s.getDuplicateCandidate(ctx, candidateID)
+	if err != nil {
+		return nil, err
+	}
+	if candidate.Status != domain.DuplicateStatusPending {
+		return nil, domain.ErrDuplicateResolved
+	}
+
+	sourceID, targetID, err := s.duplicateMergeDirection(ctx, candidate, req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The merge marks the pending candidate of this pair accepted in the same transaction
+	return s.MergeTags(ctx, dto.MergeTagsRequest{
+		SourceID: sourceID.String(),
+		TargetID: targetID.String(),
+	}, reviewerID)
+}
+
+// DismissDuplicate marks the pair as not a duplicate so it is never suggested again
+func (s *tagServiceV2) DismissDuplicate(ctx context.Context, candidateID string, reviewerID uuid.UUID) error {
+	candidate, err := s.getDuplicateCandidate(ctx, candidateID)
+	if err != nil {
+		return err
+	}
+
+	return s.tagRepo.ResolveDuplicateCandidate(ctx, candidate.ID, domain.DuplicateStatusDismissed, reviewerID)
+}
+
+// getDuplicateCandidate parses the candidate ID and loads it
+func (s *tagServiceV2) getDuplicateCandidate(ctx context.Context, candidateID string) (*domain.TagDuplicateCandidate, error) {
+	id, err := strconv.ParseUint(candidateID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid duplicate ID: %w", domain.ErrInvalidRequest, err)
+	}
+
+	return s.tagRepo.GetDuplicateCandidateByID(ctx, uint(id))
+}
+
+// duplicateMergeDirection decides which tag of the pair survives the merge
+func (s *tagServiceV2) duplicateMergeDirection(ctx context.Context, candidate *domain.TagDuplicateCandidate, requestedTarget string) (source, target uuid.UUID, err error) {
+	if requestedTarget != "" {
+		targetUUID, err := uuid.Parse(requestedTarget)
+		if err != nil {
+			return uuid.Nil, uuid.Nil, fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+		}
+		switch targetUUID {
+		case candidate.TagAID:
+			return candidate.TagBID, candidate.TagAID, nil
+		case candidate.TagBID:
+			return candidate.TagAID, candidate.TagBID, nil
+		default:
+			return uuid.Nil, uuid.Nil, fmt.Errorf("%w: target_id must be one of the pair", domain.ErrInvalidRequest)
+		}
+	}
+
+	tagA, err := s.tagRepo.GetCanonicalByID(ctx, candidate.TagAID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("%w: %w", domain.ErrSourceTagNotFound, err)
+	}
+	tagB, err := s.tagRepo.GetCanonicalByID(ctx, candidate.TagBID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("%w: %w", domain.ErrTargetTagNotFound, err)
+	}
+
+	keepA := tagA.CreatedAt.Before(tagB.CreatedAt) || tagA.CreatedAt.Equal(tagB.CreatedAt)
+	if tagA.IsApproved != tagB.IsApproved {
+		keepA = tagA.IsApproved
+	}
+
+	if keepA {
+		return tagB.ID, tagA.ID, nil
+	}
+	return tagA.ID, tagB.ID, nil
+}
+
+// duplicateScore blends the evidence into a 0-1 score
+// Without an embedding match, slug and trigram similarity share the full weight
+func duplicateScore(embeddingDistance *float64, slugSim, trigramSim float64) float64 {
+	if embeddingDistance == nil {
+		return (slugSim + trigramSim) / 2
+	}
+
+	embeddingSim := domain.DistanceToSimilarity(*embeddingDistance)
+	return duplicateWeightEmbedding*embeddingSim +
+		duplicateWeightSlug*slugSim +
+		duplicateWeightTrigram*trigramSim
+}
+
+// slugSimilarity returns 1 - normalized Levenshtein distance between two slugs
+// Example: "machine-learning" vs "machine-learnings" → ~0.94
+func slugSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	maxLen := max(len(ra), len(rb))
+	if maxLen == 0 {
+		return 1.0
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return 1.0 - float64(prev[len(rb)])/float64(maxLen)
+}
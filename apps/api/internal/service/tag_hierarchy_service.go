@@ -0,0 +1,117 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// ============================================================
+// Tag Hierarchy Implementation
+// ============================================================
+
+// SetTagParent moves a tag with its subtree under another tag (nil parent = root)
+// Business logic:
+// 1. Tag and parent must exist
+// 2. Parent must not be the tag itself or one of its descendants (cycle)
+func (s *tagServiceV2) SetTagParent(ctx context.Context, tagID string, req dto.SetTagParentRequest) (*dto.TagResponse, error) {
+	tagUUID, err := uuid.Parse(tagID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+	}
+
+	var parentUUID *uuid.UUID
+	if req.ParentID != nil && *req.ParentID != "" {
+		parsed, err := uuid.Parse(*req.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+		}
+		if parsed == tagUUID {
+			return nil, domain.ErrTagCycle
+		}
+		parentUUID = &parsed
+	}
+
+	if err := s.tagRepo.SetCanonicalParent(ctx, tagUUID, parentUUID); err != nil {
+		return nil, err
+	}
+
+	tag, err := s.tagRepo.GetCanonicalByID(ctx, tagUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrCanonicalTagNotFound, err)
+	}
+
+	return s.toCanonicalTagResponse(tag), nil
+}
+
+// GetTagTree returns the topic tree with per-node video counts
+// approvedOnly hides unapproved tags, lifting their children to the nearest shown ancestor
+func (s *tagServiceV2) GetTagTree(ctx context.Context, approvedOnly bool) ([]dto.TagTreeNodeResponse, error) {
+	nodes, err := s.tagRepo.GetTagTreeNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tag tree: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]*domain.TagTreeNode, len(nodes))
+	for i := range nodes {
+		byID[nodes[i].ID] = &nodes[i]
+	}
+
+	// visibleParent walks up until it finds a shown ancestor (nil = root)
+	// The depth guard protects against cycles in bad data
+	visibleParent := func(node *domain.TagTreeNode) *uuid.UUID {
+		parentID := node.ParentID
+		for depth := 0; parentID != nil && depth < len(nodes); depth++ {
+			parent, ok := byID[*parentID]
+			if !ok {
+				return nil // Dangling parent → treat as root
+			}
+			if !approvedOnly || parent.IsApproved {
+				return parentID
+			}
+			parentID = parent.ParentID
+		}
+		return nil
+	}
+
+	children := make(map[uuid.UUID][]*domain.TagTreeNode)
+	var roots []*domain.TagTreeNode
+	for i := range nodes {
+		node := &nodes[i]
+		if approvedOnly && !node.IsApproved {
+			continue
+		}
+		if parentID := visibleParent(node); parentID != nil {
+			children[*parentID] = append(children[*parentID], node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+
+	var build func(list []*domain.TagTreeNode) []dto.TagTreeNodeResponse
+	build = func(list []*domain.TagTreeNode) []dto.TagTreeNodeResponse {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].DisplayName < list[j].DisplayName })
+
+		result := make([]dto.TagTreeNodeResponse, len(list))
+		for i, node := range list {
+			result[i] = dto.TagTreeNodeResponse{
+				ID:              node.ID.String(),
+				Name:            node.DisplayName,
+				Slug:            node.Slug,
+				IsApproved:      node.IsApproved,
+				VideoCount:      node.VideoCount,
+				TotalVideoCount: node.TotalVideoCount,
+				Children:        build(children[node.ID]),
+			}
+		}
+		return result
+	}
+
+	tree := build(roots)
+	s.localizeTagTree(ctx, tree)
+	return tree, nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// ============================================================
+// Tag Merge Undo Implementation
+// ============================================================
+
+// UndoMerge restores the source tag of a merge, provided nothing changed since
+func (s *tagServiceV2) UndoMerge(ctx context.Context, mergeID string, undoneBy uuid.UUID) (*dto.UndoMergeResponse, error) {
+	id, err := strconv.ParseUint(mergeID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid merge ID: %w", domain.ErrInvalidRequest, err)
+	}
+
+	mergeLog, err := s.tagRepo.UndoMerge(ctx, uint(id), undoneBy)
+	if err != nil {
+		return nil, err
+	}
+
+	restored, err := s.tagRepo.GetCanonicalByID(ctx, mergeLog.SourceTagID)
+	if err != nil {
+		return nil, fmt.Errorf("merge undone but failed to load restored tag: %w", err)
+	}
+	target, err := s.tagRepo.GetCanonicalByID(ctx, mergeLog.TargetTagID)
+	if err != nil {
+		return nil, fmt.Errorf("merge undone but failed to load target tag: %w", err)
+	}
+
+	removedLinks := 0
+	for _, v := range mergeLog.Videos {
+		if v.LinkCreated {
+			removedLinks++
+		}
+	}
+
+	return &dto.UndoMergeResponse{
+		MergeID:            mergeLog.ID,
+		RestoredTag:        *s.toCanonicalTagResponse(restored),
+		TargetTag:          *s.toCanonicalTagResponse(target),
+		RestoredAliasCount: mergeLog.MovedAliasCount(),
+		RestoredVideoCount: len(mergeLog.Videos),
+		RemovedTargetLinks: removedLinks,
+	}, nil
+}
+
+// ============================================================
+// Tag Delete Implementation
+// ============================================================
+
+// DeleteTag deletes a canonical tag
+// Without reassignTo: videos are unlinked and aliases deleted (not undoable)
+// With reassignTo: the tag is merged into that tag (undoable via UndoMerge)
+func (s *tagServiceV2) DeleteTag(ctx context.Context, tagID, reassignTo string, deletedBy uuid.UUID, isAdmin bool) (*dto.DeleteTagResponse, error) {
+	tagUUID, err := uuid.Parse(tagID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid tag ID: %w", domain.ErrInvalidRequest, err)
+	}
+
+	tag, err := s.tagRepo.GetCanonicalByID(ctx, tagUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrCanonicalTagNotFound, err)
+	}
+
+	if tag.IsApproved && !isAdmin {
+		return nil, domain.ErrAdminRequired
+	}
+
+	if reassignTo != "" {
+		merged, err := s.MergeTags(ctx, dto.MergeTagsRequest{
+			SourceID: tagUUID.String(),
+			TargetID: reassignTo,
+		}, deletedBy)
+		if err != nil {
+			return nil, err
+		}
+
+		return &dto.DeleteTagResponse{
+			DeletedTagID:       tagUUID.String(),
+			ReassignedTo:       &merged.TargetTag,
+			AffectedVideoCount: merged.MergedVideoCount,
+			AffectedAliasCount: merged.MergedAliasCount,
+			MergeID:            &merged.MergeID,
+		}, nil
+	}
+
+	videoCount, aliasCount, err := s.tagRepo.DeleteCanonicalTag(ctx, tagUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.DeleteTagResponse{
+		DeletedTagID:       tagUUID.String(),
+		AffectedVideoCount: videoCount,
+		AffectedAliasCount: aliasCount,
+	}, nil
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// resolutionPolicyTTL bounds how long an API instance keeps using its cached policy
+// after another instance saved a new one (the instance that saved it refreshes immediately)
+const resolutionPolicyTTL = 30 * time.Second
+
+// resolutionPolicyCache holds the policy ResolveTag reads on every call
+type resolutionPolicyCache struct {
+	mu       sync.RWMutex
+	policy   *domain.ResolutionPolicy
+	loadedAt time.Time
+}
+
+// ============================================================
+// Resolution Policy Implementation
+// ============================================================
+
+// resolutionPolicy returns the current policy settings (cached for resolutionPolicyTTL)
+// If the policy cannot be loaded the last known (or default) policy is used: resolution never fails because of it
+func (s *tagServiceV2) resolutionPolicy(ctx context.Context) domain.ResolutionPolicySettings {
+	s.policy.mu.RLock()
+	cached, loadedAt := s.policy.policy, s.policy.loadedAt
+	s.policy.mu.RUnlock()
+
+	if cached != nil && time.Since(loadedAt) < resolutionPolicyTTL {
+		return cached.ResolutionPolicySettings
+	}
+
+	policy, err := s.tagRepo.GetResolutionPolicy(ctx)
+	if err != nil {
+		fmt.Printf("[TAG] ⚠ Failed to load resolution policy: %v (using last known policy)\n", err)
+		if cached != nil {
+			return cached.ResolutionPolicySettings
+		}
+		return domain.DefaultResolutionPolicy().ResolutionPolicySettings
+	}
+
+	s.cacheResolutionPolicy(policy)
+	return policy.ResolutionPolicySettings
+}
+
+// cacheResolutionPolicy replaces the cached policy
+func (s *tagServiceV2) cacheResolutionPolicy(policy *domain.ResolutionPolicy) {
+	s.policy.mu.Lock()
+	s.policy.policy = policy
+	s.policy.loadedAt = time.Now()
+	s.policy.mu.Unlock()
+}
+
+// GetResolutionPolicy returns the stored policy (always read from the database)
+func (s *tagServiceV2) GetResolutionPolicy(ctx context.Context) (*dto.ResolutionPolicyResponse, error) {
+	policy, err := s.tagRepo.GetResolutionPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.cacheResolutionPolicy(policy)
+	return toResolutionPolicyResponse(policy), nil
+}
+
+// UpdateResolutionPolicy applies the given fields to the current policy and records the change
+// Fields left out of the request keep their value
+func (s *tagServiceV2) UpdateResolutionPolicy(ctx context.Context, req dto.UpdateResolutionPolicyRequest, changedBy uuid.UUID) (*dto.ResolutionPolicyResponse, error) {
+	policy, err := s.tagRepo.UpdateResolutionPolicy(ctx, func(current domain.ResolutionPolicySettings) (domain.ResolutionPolicySettings, error) {
+		next := current
+		next.LanguageDistances = make(map[string]float64, len(current.LanguageDistances))
+		for language, distance := range current.LanguageDistances {
+			next.LanguageDistances[language] = distance
+		}
+
+		if req.AutoMergeDistance != nil {
+			next.AutoMergeDistance = *req.AutoMergeDistance
+		}
+		if req.LanguageDistances != nil {
+			// Replaces the whole map; {} removes all overrides
+			next.LanguageDistances = make(map[string]float64, len(req.LanguageDistances))
+			for language, distance := range req.LanguageDistances {
+				next.LanguageDistances[strings.ToLower(strings.TrimSpace(language))] = distance
+			}
+		}
+		if req.MatchStrategy != nil {
+			next.MatchStrategy = domain.MatchStrategy(*req.MatchStrategy)
+		}
+		if req.MergeMode != nil {
+			next.MergeMode = domain.MergeMode(*req.MergeMode)
+		}
+		if req.TranslationEnabled != nil {
+			next.TranslationEnabled = *req.TranslationEnabled
+		}
+		if req.AutoApproveAliases != nil {
+			next.AutoApproveAliases = *req.AutoApproveAliases
+		}
+		if req.CreateWithoutEmbedding != nil {
+			next.CreateWithoutEmbedding = *req.CreateWithoutEmbedding
+		}
+
+		return next, next.Validate()
+	}, changedBy, strings.TrimSpace(req.Reason))
+	if err != nil {
+		return nil, err
+	}
+
+	s.cacheResolutionPolicy(policy)
+	fmt.Printf("[TAG] Resolution policy updated by %s: %+v\n", changedBy, policy.ResolutionPolicySettings)
+	return toResolutionPolicyResponse(policy), nil
+}
+
+// ListResolutionPolicyChanges returns the policy audit trail, newest first
+func (s *tagServiceV2) ListResolutionPolicyChanges(ctx context.Context, page, limit int) (*dto.ResolutionPolicyChangeListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 20
+	}
+
+	changes, total, err := s.tagRepo.ListResolutionPolicyChanges(ctx, page, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policy changes: %w", err)
+	}
+
+	items := make([]dto.ResolutionPolicyChangeResponse, len(changes))
+	for i, change := range changes {
+		items[i] = dto.ResolutionPolicyChangeResponse{
+			ID:        change.ID,
+			ChangedBy: change.ChangedBy.String(),
+			Before:    toResolutionPolicySettingsResponse(change.Before),
+			After:     toResolutionPolicySettingsResponse(change.After),
+			Reason:    change.Reason,
+			CreatedAt: change.CreatedAt,
+		}
+	}
+
+	return &dto.ResolutionPolicyChangeListResponse{
+		Data: items,
+		Pagination: dto.PaginationMetadata{
+			Page:       page,
+			Limit:      limit,
+			TotalItems: total,
+			TotalPages: int(math.Ceil(float64(total) / float64(limit))),
+		},
+	}, nil
+}
+
+// toResolutionPolicyResponse converts the stored policy
+func toResolutionPolicyResponse(policy *domain.ResolutionPolicy) *dto.ResolutionPolicyResponse {
+	response := &dto.ResolutionPolicyResponse{
+		ResolutionPolicySettingsResponse: toResolutionPolicySettingsResponse(policy.ResolutionPolicySettings),
+	}
+	if policy.UpdatedBy != nil {
+		updatedBy := policy.UpdatedBy.String()
+		response.UpdatedBy = &updatedBy
+		response.UpdatedAt = &policy.UpdatedAt
+	}
+	return response
+}
+
+// toResolutionPolicySettingsResponse converts policy settings (similarities are derived for readability)
+func toResolutionPolicySettingsResponse(settings domain.ResolutionPolicySettings) dto.ResolutionPolicySettingsResponse {
+	languageDistances := settings.LanguageDistances
+	if languageDistances == nil {
+		languageDistances = map[string]float64{}
+	}
+	matchStrategy := settings.MatchStrategy
+	if matchStrategy == "" {
+		matchStrategy = domain.MatchStrategyAliasMin // Audit entries saved before strategies existed
+	}
+	return dto.ResolutionPolicySettingsResponse{
+		AutoMergeDistance:      settings.AutoMergeDistance,
+		AutoMergeSimilarity:    domain.DistanceToSimilarity(settings.AutoMergeDistance),
+		LanguageDistances:      languageDistances,
+		MatchStrategy:          string(matchStrategy),
+		MergeMode:              string(settings.MergeMode),
+		TranslationEnabled:     settings.TranslationEnabled,
+		AutoApproveAliases:     settings.AutoApproveAliases,
+		CreateWithoutEmbedding: settings.CreateWithoutEmbedding,
+	}
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ============================================================
+// User Tag Proposals Implementation
+// ============================================================
+
+// ProposeTag stores a pending tag proposal for a video
+// The name is only resolved into a canonical tag when a mod approves it, so proposals never create tags
+func (s *tagServiceV2) ProposeTag(ctx context.Context, videoID string, proposerID uuid.UUID, req dto.CreateTagProposalRequest) (*dto.TagProposalResponse, error) {
+	videoUUID, err := uuid.Parse(videoID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid video ID", domain.ErrInvalidRequest)
+	}
+	if _, err := s.videoRepo.GetVideoByID(videoUUID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrVideoNotFound, err)
+	}
+
+	normalized := domain.NormalizeText(req.TagName)
+	if normalized == "" {
+		return nil, fmt.Errorf("%w: tag name cannot be empty", domain.ErrInvalidRequest)
+	}
+
+	// Nothing to propose if the name already points to a tag on the video
+	existing, err := s.tagRepo.GetCanonicalByAlias(ctx, normalized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up tag: %w", err)
+	}
+	if existing != nil {
+		videoTags, err := s.tagRepo.GetCanonicalTagsByVideoID(ctx, videoUUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load video tags: %w", err)
+		}
+		for _, tag := range videoTags {
+			if tag.ID == existing.ID {
+				return nil, fmt.Errorf("%w: '%s'", domain.ErrVideoAlreadyTagged, existing.DisplayName)
+			}
+		}
+	}
+
+	proposal := &domain.TagProposal{
+		VideoID:        videoUUID,
+		ProposerID:     proposerID,
+		TagName:        strings.TrimSpace(req.TagName),
+		NormalizedText: normalized,
+		Status:         domain.TagProposalPending,
+	}
+	if err := s.tagRepo.CreateTagProposal(ctx, proposal); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("[TAG] Proposal '%s' for video %s by user %s\n", proposal.TagName, videoUUID, proposerID)
+	return s.getTagProposalResponse(ctx, proposal.ID)
+}
+
+// ListTagProposals returns proposals by status ("" = all), optionally only those of one proposer
+func (s *tagServiceV2) ListTagProposals(ctx context.Context, status string, proposerID *uuid.UUID, page, limit int) (*dto.TagProposalListResponse, error) {
+	filter := domain.TagProposalFilter{ProposerID: proposerID}
+	switch domain.TagProposalStatus(status) {
+	case "", domain.TagProposalPending, domain.TagProposalApproved, domain.TagProposalRejected:
+		filter.Status = domain.TagProposalStatus(status)
+	default:
+		return nil, fmt.Errorf("%w: status must be pending, approved or rejected", domain.ErrInvalidRequest)
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 20
+	}
+
+	proposals, total, err := s.tagRepo.ListTagProposals(ctx, filter, page, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tag proposals: %w", err)
+	}
+
+	items := make([]dto.TagProposalResponse, 0, len(proposals))
+	for i := range proposals {
+		items = append(items, *s.toTagProposalResponse(&proposals[i]))
+	}
+
+	return &dto.TagProposalListResponse{
+		Data: items,
+		Pagination: dto.PaginationMetadata{
+			Page:       page,
+			Limit:      limit,
+			TotalItems: total,
+			TotalPages: int(math.Ceil(float64(total) / float64(limit))),
+		},
+	}, nil
+}
+
+// ApproveTagProposal links the proposed tag to the video
+// The tag is req.TagID if given, otherwise the proposed name resolved with ResolveTag (may create a new canonical)
+func (s *tagServiceV2) ApproveTagProposal(ctx context.Context, proposalID string, reviewerID uuid.UUID, req dto.ApproveTagProposalRequest) (*dto.TagProposalResponse, error) {
+	proposal, err := s.getTagProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != domain.TagProposalPending {
+		return nil, domain.ErrProposalReviewed
+	}
+	if proposal.Video == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrVideoNotFound, proposal.VideoID)
+	}
+
+	var canonical *domain.CanonicalTag
+	if req.TagID != nil {
+		tagUUID, err := uuid.Parse(*req.TagID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid tag ID", domain.ErrInvalidRequest)
+		}
+		canonical, err = s.tagRepo.GetCanonicalByID(ctx, tagUUID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrCanonicalTagNotFound, err)
+		}
+	} else {
+		canonical, _, _, err = s.ResolveTag(ctx, proposal.TagName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve tag: %w", err)
+		}
+	}
+
+	if err := s.tagRepo.ApproveTagProposal(ctx, proposal.ID, canonical.ID, reviewerID, req.ApproveTag); err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("[TAG] Proposal %s approved by %s → '%s'\n", proposal.ID, reviewerID, canonical.DisplayName)
+	return s.getTagProposalResponse(ctx, proposal.ID)
+}
+
+// RejectTagProposal closes the proposal without linking the tag
+func (s *tagServiceV2) RejectTagProposal(ctx context.Context, proposalID string, reviewerID uuid.UUID, req dto.RejectTagProposalRequest) (*dto.TagProposalResponse, error) {
+	id, err := uuid.Parse(proposalID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid proposal ID", domain.ErrInvalidRequest)
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: rejection reason cannot be empty", domain.ErrInvalidRequest)
+	}
+
+	if err := s.tagRepo.RejectTagProposal(ctx, id, reviewerID, reason); err != nil {
+		return nil, err
+	}
+
+	return s.getTagProposalResponse(ctx, id)
+}
+
+// getTagProposal parses the ID and loads the proposal
+func (s *tagServiceV2) getTagProposal(ctx context.Context, proposalID string) (*domain.TagProposal, error) {
+	id, err := uuid.Parse(proposalID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid proposal ID", domain.ErrInvalidRequest)
+	}
+	return s.tagRepo.GetTagProposalByID(ctx, id)
+}
+
+// getTagProposalResponse reloads a proposal (with relations) and converts it
+func (s *tagServiceV2) getTagProposalResponse(ctx context.Context, id uuid.UUID) (*dto.TagProposalResponse, error) {
+	proposal, err := s.tagRepo.GetTagProposalByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.toTagProposalResponse(proposal), nil
+}
+
+// toTagProposalResponse converts a proposal with preloaded relations
+func (s *tagServiceV2) toTagProposalResponse(proposal *domain.TagProposal) *dto.TagProposalResponse {
+	response := &dto.TagProposalResponse{
+		ID:              proposal.ID.String(),
+		ProposerID:      proposal.ProposerID.String(),
+		TagName:         proposal.TagName,
+		Status:          string(proposal.Status),
+		RejectionReason: proposal.RejectionReason,
+		ReviewedAt:      proposal.ReviewedAt,
+		CreatedAt:       proposal.CreatedAt,
+	}
+	if proposal.Video != nil {
+		response.Video = &toVideoRefResponses([]domain.Video{*proposal.Video})[0]
+	}
+	if proposal.Proposer != nil {
+		response.ProposerUsername = proposal.Proposer.Username
+	}
+	if proposal.CanonicalTag != nil {
+		response.Tag = s.toCanonicalTagResponse(proposal.CanonicalTag)
+	}
+	if proposal.ReviewedBy != nil {
+		reviewedBy := proposal.ReviewedBy.String()
+		response.ReviewedBy = &reviewedBy
+	}
+	return response
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Related tags settings
+const (
+	RELATED_NEIGHBORS_PER_TAG = 10   // Closest centroids considered per tag (besides co-occurring tags)
+	RELATED_TAGS_PER_TAG      = 20   // Relations kept per tag after scoring
+	RELATED_MIN_SCORE         = 0.25 // Relations scoring below this are dropped
+
+	// Pairs that share no video are kept only when their centroids are this close (~85% similarity)
+	// Otherwise every tag would get its K nearest neighbours as "related", however far they are
+	RELATED_EMBEDDING_ONLY_MAX_DISTANCE = 0.30
+)
+
+// Score weights (sum to 1)
+const (
+	relatedWeightCoOccurrence = 0.6
+	relatedWeightEmbedding    = 0.4
+)
+
+// ============================================================
+// Related Tags Implementation
+// ============================================================
+
+// RefreshRelatedTags recomputes related tags for every canonical tag
+// Score blends co-occurrence (Jaccard) with centroid similarity of alias embeddings
+func (s *tagServiceV2) RefreshRelatedTags(ctx context.Context) (int, error) {
+	pairs, err := s.tagRepo.FindRelatedTagPairs(ctx, RELATED_NEIGHBORS_PER_TAG)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find related tag pairs: %w", err)
+	}
+
+	now := time.Now()
+	byTag := make(map[uuid.UUID][]domain.TagRelation)
+	for _, p := range pairs {
+		if p.CoOccurrenceCount == 0 && (p.CentroidDistance == nil || *p.CentroidDistance > RELATED_EMBEDDING_ONLY_MAX_DISTANCE) {
+			continue
+		}
+
+		score := relatedScore(p.Jaccard, p.CentroidDistance)
+		if score < RELATED_MIN_SCORE {
+			continue
+		}
+
+		byTag[p.TagID] = append(byTag[p.TagID], domain.TagRelation{
+			TagID:             p.TagID,
+			RelatedTagID:      p.RelatedTagID,
+			Score:             score,
+			Jaccard:           p.Jaccard,
+			CentroidDistance:  p.CentroidDistance,
+			CoOccurrenceCount: p.CoOccurrenceCount,
+			UpdatedAt:         now,
+		})
+	}
+
+	relations := make([]domain.TagRelation, 0, len(pairs))
+	for _, rels := range byTag {
+		sort.Slice(rels, func(i, j int) bool { return rels[i].Score > rels[j].Score })
+		if len(rels) > RELATED_TAGS_PER_TAG {
+			rels = rels[:RELATED_TAGS_PER_TAG]
+		}
+		relations = append(relations, rels...)
+	}
+
+	if err := s.tagRepo.ReplaceTagRelations(ctx, relations); err != nil {
+		return 0, fmt.Errorf("failed to save related tags: %w", err)
+	}
+
+	return len(relations), nil
+}
+
+// GetRelatedTags returns the "see also" tags of a canonical tag, highest score first
+func (s *tagServiceV2) GetRelatedTags(ctx context.Context, tagID string, limit int, approvedOnly bool) ([]dto.RelatedTagResponse, error) {
+	tagUUID, err := uuid.Parse(tagID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid tag ID: %w", domain.ErrInvalidRequest, err)
+	}
+	if limit < 1 || limit > RELATED_TAGS_PER_TAG {
+		limit = RELATED_TAGS_PER_TAG
+	}
+
+	if _, err := s.tagRepo.GetCanonicalByID(ctx, tagUUID); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrCanonicalTagNotFound, err)
+	}
+
+	related, err := s.tagRepo.GetRelatedTags(ctx, tagUUID, limit, approvedOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]dto.RelatedTagResponse, 0, len(related))
+	for _, rt := range related {
+		var embeddingSim *float64
+		if rt.Relation.CentroidDistance != nil {
+			sim := domain.DistanceToSimilarity(*rt.Relation.CentroidDistance)
+			embeddingSim = &sim
+		}
+
+		results = append(results, dto.RelatedTagResponse{
+			Tag:                 *s.toCanonicalTagResponse(&rt.Tag),
+			Score:               rt.Relation.Score,
+			CoOccurrenceCount:   rt.Relation.CoOccurrenceCount,
+			Jaccard:             rt.Relation.Jaccard,
+			EmbeddingSimilarity: embeddingSim,
+		})
+	}
+
+	tags := make([]*dto.TagResponse, len(results))
+	for i := range results {
+		tags[i] = &results[i].Tag
+	}
+	s.localizeTagResponses(ctx, tags...)
+
+	return results, nil
+}
+
+// relatedScore blends co-occurrence and embedding evidence into a 0-1 score
+// Without embeddings, co-occurrence carries the full weight
+func relatedScore(jaccard float64, centroidDistance *float64) float64 {
+	if centroidDistance == nil {
+		return jaccard
+	}
+
+	embeddingSim := domain.DistanceToSimilarity(*centroidDistance)
+	return relatedWeightCoOccurrence*jaccard + relatedWeightEmbedding*embeddingSim
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+)
+
+// ============================================================
+// Rename & Slug History Implementation
+// ============================================================
+
+// RenameTag renames a canonical tag
+// Business logic:
+// 1. Slug is regenerated from the new name; collisions get a numeric suffix ("ai-2")
+// 2. Old slug is kept in history so /tags/by-slug/{old} still resolves
+// 3. Old display name is kept as an alias so tag resolution still finds the tag
+func (s *tagServiceV2) RenameTag(ctx context.Context, tagID string, req dto.RenameTagRequest) (*dto.RenameTagResponse, error) {
+	tagUUID, err := uuid.Parse(tagID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+	}
+
+	newName := strings.TrimSpace(req.Name)
+	if newName == "" {
+		return nil, fmt.Errorf("%w: name cannot be empty", domain.ErrInvalidRequest)
+	}
+
+	tag, err := s.tagRepo.GetCanonicalByID(ctx, tagUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrCanonicalTagNotFound, err)
+	}
+	oldName, oldSlug := tag.DisplayName, tag.Slug
+
+	candidates, err := domain.SlugCandidates(newName, domain.MaxSlugSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+	}
+
+	oldNameAlias, err := s.oldNameAlias(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	renamed, err := s.tagRepo.RenameCanonicalTag(ctx, tagUUID, newName, candidates, oldNameAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reload with aliases for the response
+	if reloaded, err := s.tagRepo.GetCanonicalByID(ctx, renamed.ID); err == nil {
+		renamed = reloaded
+	}
+
+	return &dto.RenameTagResponse{
+		Tag:     *s.toCanonicalTagResponse(renamed),
+		OldName: oldName,
+		OldSlug: oldSlug,
+	}, nil
+}
+
+// oldNameAlias builds the alias that preserves a tag's current display name
+// Returns nil if that text already resolves to some tag (its own alias, or another tag's)
+func (s *tagServiceV2) oldNameAlias(ctx context.Context, tag *domain.CanonicalTag) (*domain.TagAlias, error) {
+	owner, err := s.tagRepo.GetCanonicalByAlias(ctx, domain.NormalizeText(tag.DisplayName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to check old name alias: %w", err)
+	}
+	if owner != nil {
+		if owner.ID != tag.ID {
+			fmt.Printf("[RENAME_TAG] ⚠ Old name '%s' is an alias of another tag (%s) - not kept\n", tag.DisplayName, owner.ID)
+		}
+		return nil, nil
+	}
+
+	// Embedding keeps the alias usable for semantic search; empty if OpenAI is unavailable
+	embedding := pgvector.Vector{}
+	if embeddingSlice, embErr := s.tagRepo.GetEmbeddingForText(ctx, tag.DisplayName); embErr == nil {
+		embedding = pgvector.NewVector(embeddingSlice)
+	}
+
+	alias, err := domain.NewTagAlias(tag.DisplayName, tag.ID, embedding, 1.0)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+	}
+	alias.IsReviewed = true // Chosen by a mod, not AI-mapped
+	return alias, nil
+}
+
+// GetTagBySlug resolves a current or historic slug to the current tag
+func (s *tagServiceV2) GetTagBySlug(ctx context.Context, slug string) (*dto.TagBySlugResponse, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if slug == "" {
+		return nil, fmt.Errorf("%w: slug cannot be empty", domain.ErrInvalidRequest)
+	}
+
+	redirected := false
+	tag, err := s.tagRepo.GetCanonicalBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag by slug: %w", err)
+	}
+	if tag == nil {
+		tag, err = s.tagRepo.GetCanonicalBySlugHistory(ctx, slug)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tag by slug history: %w", err)
+		}
+		if tag == nil {
+			return nil, domain.ErrCanonicalTagNotFound
+		}
+		redirected = true
+	}
+
+	if reloaded, err := s.tagRepo.GetCanonicalByID(ctx, tag.ID); err == nil {
+		tag = reloaded
+	}
+
+	response, err := s.tagResponseWithDisplayNames(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.TagBySlugResponse{
+		Tag:        *response,
+		Redirected: redirected,
+	}, nil
+}
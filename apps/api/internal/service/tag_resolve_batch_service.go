@@ -0,0 +1,242 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ============================================================
+// Batch Tag Resolution Implementation
+// ============================================================
+
+// batchResolution is the outcome of resolving one distinct normalized input
+type batchResolution struct {
+	canonical  *domain.CanonicalTag
+	decision   domain.ResolveDecision
+	translated *string
+	err        error
+}
+
+// ResolveTagBatch resolves many tag names (e.g. a comma-separated list pasted by a mod)
+// Layer 1 is one lookup for all inputs; only the misses are translated and embedded, each in one call.
+// With req.VideoID every resolved tag is linked to that video in one transaction.
+func (s *tagServiceV2) ResolveTagBatch(ctx context.Context, req dto.ResolveTagBatchRequest) (*dto.ResolveTagBatchResponse, error) {
+	var videoUUID uuid.UUID
+	if req.VideoID != nil {
+		if err := s.checkVideoExists(*req.VideoID); err != nil {
+			return nil, err
+		}
+		videoUUID = uuid.MustParse(*req.VideoID) // Validated by checkVideoExists
+	}
+
+	// 1. Resolve distinct inputs (first spelling of a normalized text wins)
+	var inputs []string
+	seen := make(map[string]bool)
+	for _, name := range req.Names {
+		key := domain.NormalizeText(name)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		inputs = append(inputs, name)
+	}
+
+	resolved, err := s.resolveBatchInputs(ctx, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ResolveTagBatchResponse{Results: make([]dto.TagBatchResolution, 0, len(req.Names))}
+	for _, r := range resolved {
+		if r.err == nil && r.decision.CreatesTag() {
+			response.CreatedCount++
+		}
+	}
+
+	// 2. Link every resolved tag to the video at once
+	created := make(map[uuid.UUID]bool)
+	if req.VideoID != nil {
+		var links []domain.VideoTagLink
+		linkedTags := make(map[uuid.UUID]bool)
+		for _, input := range inputs {
+			r := resolved[domain.NormalizeText(input)]
+			if r.err != nil || linkedTags[r.canonical.ID] {
+				continue
+			}
+			linkedTags[r.canonical.ID] = true
+			links = append(links, domain.VideoTagLink{VideoID: videoUUID, CanonicalTagID: r.canonical.ID})
+		}
+
+		if len(links) > 0 {
+			inserted, err := s.tagRepo.AddCanonicalTagsToVideos(ctx, links)
+			if err != nil {
+				return nil, fmt.Errorf("failed to link tags to video: %w", err)
+			}
+			for _, link := range inserted {
+				created[link.CanonicalTagID] = true
+			}
+			response.LinkedCount = len(inserted)
+		}
+	}
+
+	// 3. Per-input results in request order (the first input claiming a created link reports "added")
+	for _, name := range req.Names {
+		key := domain.NormalizeText(name)
+		result := dto.TagBatchResolution{Input: name, NormalizedText: key}
+
+		r, ok := resolved[key]
+		switch {
+		case !ok:
+			result.Error = fmt.Sprintf("%s: tag name is empty", domain.ErrInvalidRequest)
+		case r.err != nil:
+			result.Error = r.err.Error()
+		default:
+			result.Layer = r.decision.Layer()
+			result.Decision = string(r.decision)
+			result.TranslatedText = r.translated
+			result.Tag = s.toCanonicalTagResponse(r.canonical)
+			result.IsNew = r.decision.CreatesTag()
+			if req.VideoID != nil {
+				if created[r.canonical.ID] {
+					result.LinkStatus = dto.BulkTagStatusAdded
+					delete(created, r.canonical.ID)
+				} else {
+					result.LinkStatus = dto.BulkTagStatusAlreadyTagged
+				}
+			}
+		}
+
+		if result.Error != "" {
+			response.FailedCount++
+		} else {
+			response.ResolvedCount++
+		}
+		response.Results = append(response.Results, result)
+	}
+
+	return response, nil
+}
+
+// resolveBatchInputs resolves distinct tag names, keyed by normalized text
+// Only database errors in the batched Layer 1 lookup fail the whole batch; other failures are per input
+func (s *tagServiceV2) resolveBatchInputs(ctx context.Context, inputs []string) (map[string]batchResolution, error) {
+	resolved := make(map[string]batchResolution, len(inputs))
+
+	// Layer 1: Exact match for all inputs in one lookup
+	keys := make([]string, len(inputs))
+	for i, input := range inputs {
+		keys[i] = domain.NormalizeText(input)
+	}
+	exact, err := s.tagRepo.GetCanonicalsByAliases(ctx, keys)
+	if err != nil {
+		return nil, fmt.Errorf("Layer 1 failed: %w", err)
+	}
+
+	var misses []string
+	for i, input := range inputs {
+		if canonical, ok := exact[keys[i]]; ok {
+			resolved[keys[i]] = batchResolution{canonical: canonical, decision: domain.ResolveDecisionExactMatch}
+			continue
+		}
+		misses = append(misses, input)
+	}
+	fmt.Printf("[BATCH_RESOLVE] %d inputs: %d exact matches, %d to resolve\n", len(inputs), len(inputs)-len(misses), len(misses))
+	if len(misses) == 0 {
+		return resolved, nil
+	}
+
+	// Layer 1.5: One translation call for the non-English misses, one lookup for the translations
+	policy := s.resolutionPolicy(ctx)
+	translations := make(map[string]string)
+	if policy.TranslationEnabled {
+		translations = s.translateBatch(ctx, misses)
+	}
+	engKeys := make([]string, 0, len(translations))
+	for _, englishTerm := range translations {
+		engKeys = append(engKeys, domain.NormalizeText(englishTerm))
+	}
+	translatedHits, err := s.tagRepo.GetCanonicalsByAliases(ctx, engKeys)
+	if err != nil {
+		fmt.Printf("[BATCH_RESOLVE] ⚠ Translation lookup failed: %v (continuing to Layer 2)\n", err)
+		translatedHits = nil
+	}
+
+	// Layer 2: One embeddings call for all misses; without it resolveByEmbedding falls back per input
+	embeddings, err := s.tagRepo.GetEmbeddingsForTexts(ctx, misses)
+	if err != nil {
+		fmt.Printf("[BATCH_RESOLVE] ⚠ Batch embedding failed: %v (embedding one by one)\n", err)
+		embeddings = nil
+	}
+
+	// Layers 3-4: Decide each input
+	// Sequential on purpose: a tag created for one input is visible to the next (Layer 1.5 and 3)
+	for i, input := range misses {
+		key := domain.NormalizeText(input)
+		var precomputed []float32
+		if embeddings != nil {
+			precomputed = embeddings[i]
+		}
+
+		var r batchResolution
+		if englishTerm, ok := translations[key]; ok {
+			r.translated = &englishTerm
+
+			engKey := domain.NormalizeText(englishTerm)
+			target := translatedHits[engKey]
+			if prior, ok := resolved[engKey]; target == nil && ok && prior.err == nil {
+				target = prior.canonical // Translation is another input of this batch
+			}
+			if target != nil {
+				s.linkTranslationAlias(ctx, input, target, precomputed, policy)
+				r.canonical, r.decision = target, domain.ResolveDecisionTranslationMatch
+				resolved[key] = r
+				continue
+			}
+		}
+
+		canonical, _, decision, err := s.resolveByEmbedding(ctx, input, key, precomputed, policy)
+		if err != nil {
+			r.err = fmt.Errorf("failed to resolve tag: %w", err)
+		} else {
+			r.canonical, r.decision = canonical, decision
+		}
+		resolved[key] = r
+	}
+
+	return resolved, nil
+}
+
+// translateBatch translates the non-English inputs in one call
+// Returns only useful translations (non-empty, different from the input), keyed by normalized input
+func (s *tagServiceV2) translateBatch(ctx context.Context, inputs []string) map[string]string {
+	translations := make(map[string]string)
+
+	var toTranslate []string
+	for _, input := range inputs {
+		if domain.DetectLanguage(input) != domain.LanguageEnglish {
+			toTranslate = append(toTranslate, input)
+		}
+	}
+	if len(toTranslate) == 0 {
+		return translations
+	}
+
+	results, err := s.tagRepo.TranslateTexts(ctx, toTranslate)
+	if err != nil {
+		fmt.Printf("[BATCH_RESOLVE] ⚠ Batch translation failed: %v (continuing to Layer 2)\n", err)
+		return translations
+	}
+
+	for i, input := range toTranslate {
+		englishTerm := strings.TrimSpace(results[i])
+		if englishTerm != "" && domain.NormalizeText(englishTerm) != domain.NormalizeText(input) {
+			translations[domain.NormalizeText(input)] = englishTerm
+		}
+	}
+	return translations
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/repository"
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// openTestDB connects to the database configured by the DB_* env vars
+// Skips the test when no database is configured, reachable or migrated
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dbName, dbUser, dbPassword := os.Getenv("DB_NAME"), os.Getenv("DB_USER"), os.Getenv("DB_PASSWORD")
+	if dbName == "" || dbUser == "" || dbPassword == "" {
+		t.Skip("DB_NAME, DB_USER and DB_PASSWORD not set - skipping database test")
+	}
+	dbHost, dbPort, dbSSLMode := os.Getenv("DB_HOST"), os.Getenv("DB_PORT"), os.Getenv("DB_SSLMODE")
+	if dbHost == "" {
+		dbHost = "localhost"
+	}
+	if dbPort == "" {
+		dbPort = "5432"
+	}
+	if dbSSLMode == "" {
+		dbSSLMode = "disable"
+	}
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s", dbUser, dbPassword, dbHost, dbPort, dbName, dbSSLMode)
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Skipf("database not reachable: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Skipf("database not reachable: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := sqlDB.PingContext(ctx); err != nil {
+		t.Skipf("database not reachable: %v", err)
+	}
+	if !db.Migrator().HasTable(&domain.CanonicalTag{}) || !db.Migrator().HasTable(&domain.TagAlias{}) {
+		t.Skip("database is not migrated - run the migrate command first")
+	}
+
+	return db
+}
+
+// TestResolveTagConcurrentSameText fires parallel ResolveTag calls for one new text:
+// every call must return the same canonical and exactly one alias must be stored
+func TestResolveTagConcurrentSameText(t *testing.T) {
+	db := openTestDB(t)
+
+	// No OpenAI client: Layer 2 fails and every call goes to the "create without embedding" path,
+	// so all of them race on CreateCanonicalTag
+	tagRepo := repository.NewTagRepository(db, nil)
+	svc := NewTagServiceV2(tagRepo, nil).(*tagServiceV2)
+	policy := domain.DefaultResolutionPolicy()
+	policy.TranslationEnabled = false
+	policy.CreateWithoutEmbedding = true
+	svc.cacheResolutionPolicy(policy)
+
+	const parallel = 20
+	input := "Concurrency Test " + uuid.NewString()[:8]
+	normalized := domain.NormalizeText(input)
+	ctx := context.Background()
+
+	ids := make([]uuid.UUID, parallel)
+	errs := make([]error, parallel)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < parallel; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			canonical, _, _, err := svc.ResolveTag(ctx, input)
+			errs[i] = err
+			if canonical != nil {
+				ids[i] = canonical.ID
+			}
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("ResolveTag call %d failed: %v", i, err)
+		}
+	}
+	t.Cleanup(func() {
+		if _, _, err := tagRepo.DeleteCanonicalTag(context.Background(), ids[0]); err != nil {
+			t.Logf("cleanup failed: %v", err)
+		}
+	})
+
+	for i, id := range ids {
+		if id != ids[0] {
+			t.Errorf("call %d returned canonical %s, want %s", i, id, ids[0])
+		}
+	}
+
+	var aliasCount int64
+	if err := db.Model(&domain.TagAlias{}).Where("normalized_text = ?", normalized).Count(&aliasCount).Error; err != nil {
+		t.Fatalf("count aliases: %v", err)
+	}
+	if aliasCount != 1 {
+		t.Errorf("got %d aliases for '%s', want 1", aliasCount, normalized)
+	}
+
+	var canonicalCount int64
+	if err := db.Model(&domain.CanonicalTag{}).
+		Where("id IN (SELECT canonical_tag_id FROM tag_aliases WHERE normalized_text = ?)", normalized).
+		Or("display_name = ?", input).
+		Count(&canonicalCount).Error; err != nil {
+		t.Fatalf("count canonicals: %v", err)
+	}
+	if canonicalCount != 1 {
+		t.Errorf("got %d canonicals for '%s', want 1", canonicalCount, input)
+	}
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	reviewQueueAlternatives  = 3 // Nearest other canonicals shown per alias
+	reviewQueueVideoSamples  = 5 // Affected videos shown per alias
+	reviewStatsDefaultWindow = 7 // Days
+)
+
+// ============================================================
+// Alias Review Queue Implementation
+// ============================================================
+
+// GetAliasReviewQueue returns unreviewed aliases, lowest similarity first
+// Each entry carries the nearest alternative canonicals and the videos that
+// are affected by the alias' current mapping
+func (s *tagServiceV2) GetAliasReviewQueue(ctx context.Context, page, limit int) (*dto.AliasReviewQueueResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 20
+	}
+
+	aliases, total, err := s.tagRepo.ListUnreviewedAliases(ctx, page, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list review queue: %w", err)
+	}
+
+	// Load everything the page needs with one query per kind (not per alias)
+	aliasIDs := make([]uuid.UUID, len(aliases))
+	canonicalIDs := make([]uuid.UUID, 0, len(aliases))
+	seen := make(map[uuid.UUID]bool, len(aliases))
+	for i, alias := range aliases {
+		aliasIDs[i] = alias.ID
+		if !seen[alias.CanonicalTagID] {
+			seen[alias.CanonicalTagID] = true
+			canonicalIDs = append(canonicalIDs, alias.CanonicalTagID)
+		}
+	}
+
+	canonicals, err := s.tagRepo.GetCanonicalsByIDs(ctx, canonicalIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load canonicals: %w", err)
+	}
+	canonicalsByID := make(map[uuid.UUID]*domain.CanonicalTag, len(canonicals))
+	for i := range canonicals {
+		canonicalsByID[canonicals[i].ID] = &canonicals[i]
+	}
+
+	matchesByAlias, err := s.tagRepo.GetAlternativeCanonicals(ctx, aliasIDs, reviewQueueAlternatives)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load alternatives: %w", err)
+	}
+
+	videosByTag, err := s.tagRepo.GetTaggedVideos(ctx, canonicalIDs, reviewQueueVideoSamples)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load videos: %w", err)
+	}
+
+	items := make([]dto.AliasReviewQueueItem, 0, len(aliases))
+	for _, alias := range aliases {
+		canonical, ok := canonicalsByID[alias.CanonicalTagID]
+		if !ok {
+			return nil, fmt.Errorf("failed to load canonical for alias %s: %w", alias.ID, domain.ErrCanonicalTagNotFound)
+		}
+
+		matches := matchesByAlias[alias.ID]
+		alternatives := make([]dto.CanonicalCandidateResponse, len(matches))
+		for i, m := range matches {
+			alternatives[i] = toCanonicalCandidateResponse(m)
+		}
+
+		items = append(items, dto.AliasReviewQueueItem{
+			Alias:              toTagAliasResponse(&alias),
+			CurrentTag:         *s.toCanonicalTagResponse(canonical),
+			Alternatives:       alternatives,
+			AffectedVideoCount: canonical.VideoCount,
+			AffectedVideos:     toVideoRefResponses(videosByTag[alias.CanonicalTagID]),
+		})
+	}
+
+	return &dto.AliasReviewQueueResponse{
+		Data: items,
+		Pagination: dto.PaginationMetadata{
+			Page:       page,
+			Limit:      limit,
+			TotalItems: total,
+			TotalPages: int(math.Ceil(float64(total) / float64(limit))),
+		},
+	}, nil
+}
+
+// AcceptAlias confirms an alias on its current canonical
+func (s *tagServiceV2) AcceptAlias(ctx context.Context, aliasID string, reviewerID uuid.UUID) (*dto.TagAliasResponse, error) {
+	aliasUUID, err := uuid.Parse(aliasID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidRequest, err)
+	}
+
+	alias, err := s.tagRepo.GetAliasByID(ctx, aliasUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	review := &domain.TagAliasReviewLog{
+		AliasID:         alias.ID,
+		ReviewerID:      reviewerID,
+		Action:          domain.AliasReviewAccept,
+		FromCanonicalID: alias.CanonicalTagID,
+		ToCanonicalID:   alias.CanonicalTagID,
+	}
+	if err := s.tagRepo.AcceptAlias(ctx, review); err != nil {
+		return nil, fmt.Errorf("failed to accept alias: %w", err)
+	}
+
+	alias.IsReviewed = true
+	response := toTagAliasResponse(alias)
+	return &response, nil
+}
+
+// ReassignAlias moves an alias to another canonical and records the review
+func (s *tagServiceV2) ReassignAlias(ctx context.Context, aliasID string, reviewerID uuid.UUID, req dto.MoveAliasRequest) (*dto.TagAliasResponse, error) {
+	return s.moveAlias(ctx, aliasID, req, &domain.TagAliasReviewLog{
+		ReviewerID: reviewerID,
+		Action:     domain.AliasReviewReassign,
+	})
+}
+
+// SplitReviewedAlias splits an alias into a new canonical and records the review
+func (s *tagServiceV2) SplitReviewedAlias(ctx context.Context, aliasID string, reviewerID uuid.UUID, req dto.SplitAliasRequest) (*dto.TagResponse, error) {
+	return s.splitAlias(ctx, aliasID, req, &domain.TagAliasReviewLog{
+		ReviewerID: reviewerID,
+		Action:     domain.AliasReviewSplit,
+	})
+}
+
+// GetAliasReviewStats returns per-mod review throughput over the last N days
+func (s *tagServiceV2) GetAliasReviewStats(ctx context.Context, days int) (*dto.AliasReviewStatsResponse, error) {
+	if days < 1 {
+		days = reviewStatsDefaultWindow
+	}
+	since := time.Now().AddDate(0, 0, -days)
+
+	stats, err := s.tagRepo.GetAliasReviewStats(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review stats: %w", err)
+	}
+
+	pending, err := s.tagRepo.CountUnreviewedAliases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count pending aliases: %w", err)
+	}
+
+	reviewers := make([]dto.AliasReviewerStatsResponse, len(stats))
+	for i, st := range stats {
+		reviewers[i] = dto.AliasReviewerStatsResponse{
+			ReviewerID:     st.ReviewerID.String(),
+			Username:       st.Username,
+			Accepted:       st.Accepted,
+			Reassigned:     st.Reassigned,
+			Split:          st.Split,
+			Total:          st.Total,
+			LastReviewedAt: st.LastReviewedAt,
+		}
+	}
+
+	return &dto.AliasReviewStatsResponse{
+		Since:        since,
+		PendingCount: pending,
+		Reviewers:    reviewers,
+	}, nil
+}
+
+// toCanonicalCandidateResponse converts domain.CanonicalMatch to dto.CanonicalCandidateResponse
+func toCanonicalCandidateResponse(m domain.CanonicalMatch) dto.CanonicalCandidateResponse {
+	return dto.CanonicalCandidateResponse{
+		CanonicalID:   m.CanonicalTagID.String(),
+		CanonicalName: m.CanonicalName,
+		Distance:      m.Distance,
+		Similarity:    domain.DistanceToSimilarity(m.Distance),
+	}
+}
+
+// toVideoRefResponses converts videos to minimal references
+func toVideoRefResponses(videos []domain.Video) []dto.VideoRefResponse {
+	refs := make([]dto.VideoRefResponse, len(videos))
+	for i, v := range videos {
+		refs[i] = dto.VideoRefResponse{
+			ID:        v.ID.String(),
+			YoutubeID: v.YoutubeID,
+			Title:     v.Title,
+		}
+	}
+	return refs
+}
@@ -3,9 +3,12 @@ package service
 import (
 	"api/internal/domain"
 	"api/internal/dto"
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
@@ -14,6 +17,7 @@ import (
 type tagServiceV2 struct {
 	tagRepo   domain.TagRepository
 	videoRepo domain.VideoRepository
+	policy    *resolutionPolicyCache
 }
 
 // NewTagServiceV2 creates a new v2 tag service instance
@@ -21,6 +25,7 @@ func NewTagServiceV2(tagRepo domain.TagRepository, videoRepo domain.VideoReposit
 	return &tagServiceV2{
 		tagRepo:   tagRepo,
 		videoRepo: videoRepo,
+		policy:    &resolutionPolicyCache{},
 	}
 }
 
@@ -28,21 +33,6 @@ func NewTagServiceV2(tagRepo domain.TagRepository, videoRepo domain.VideoReposit
 // Canonical-Alias Architecture Implementation
 // ============================================================
 
-// AUTO_MERGE_THRESHOLD controls when to auto-merge similar tags
-// This is a DISTANCE threshold (cosine distance in pgvector)
-// Distance formula: similarity = 1 - (distance / 2)
-//
-// Distance 0.20 = 90% similarity (very strict)
-// Distance 0.30 = 85% similarity (recommended)
-// Distance 0.40 = 80% similarity (too loose)
-//
-// Examples with threshold 0.30:
-// ✅ "money" ↔ "tiền" (distance ~0.25) → MERGE
-// ✅ "ML" ↔ "Machine Learning" (distance ~0.20) → MERGE
-// ❌ "money" ↔ "gái" (distance ~0.80) → DON'T MERGE
-// ❌ "finance" ↔ "sex" (distance ~1.50) → DON'T MERGE
-const AUTO_MERGE_THRESHOLD = 0.40 // 85% similarity
-
 // ResolveTag implements 4-layer resolution algorithm to get or create canonical tag
 // This is the CORE method that replaces CreateTag with zero-error flow
 //
@@ -57,7 +47,26 @@ const AUTO_MERGE_THRESHOLD = 0.40 // 85% similarity
 // - matchedAlias: The alias text that was matched (for UI feedback)
 // - isNewTag: true if new canonical was created, false if existing
 // - error: Only critical errors (DB failures, etc.), NOT duplicate errors
+//
+// Concurrency: the advisory locks (see lockTagKeys) only cover the final insert.
+// Concurrent resolutions of the same new text all run Layers 1.5-3 (OpenAI calls included);
+// the first insert wins and the others discard their work in resolveRaceWinner.
+// The result is still one canonical and one alias, at the cost of duplicate API calls.
 func (s *tagServiceV2) ResolveTag(ctx context.Context, userInput string) (*domain.CanonicalTag, string, bool, error) {
+	return s.resolveTag(ctx, userInput, nil)
+}
+
+// embeddingFor returns the precomputed embedding if given, otherwise asks OpenAI
+func (s *tagServiceV2) embeddingFor(ctx context.Context, text string, precomputed []float32) ([]float32, error) {
+	if precomputed != nil {
+		return precomputed, nil
+	}
+	return s.tagRepo.GetEmbeddingForText(ctx, text)
+}
+
+// resolveTag is ResolveTag with an optional precomputed embedding of userInput
+// Bulk callers embed all inputs in one BatchGetEmbeddings call and pass them here
+func (s *tagServiceV2) resolveTag(ctx context.Context, userInput string, precomputed []float32) (*domain.CanonicalTag, string, bool, error) {
 	fmt.Printf("\n[RESOLVE_TAG] ========================================\n")
 	fmt.Printf("[RESOLVE_TAG] Input: '%s'\n", userInput)
 
@@ -90,8 +99,13 @@ func (s *tagServiceV2) ResolveTag(ctx context.Context, userInput string) (*domai
 	// ============================================================
 	fmt.Printf("[RESOLVE_TAG] Layer 1.5: Translation Layer...\n")
 
-	// Attempt translation to English
-	englishTerm, err := s.tagRepo.TranslateText(ctx, userInput)
+	// Attempt translation to English (skipped for English input or when disabled by the policy)
+	var englishTerm string
+	policy := s.resolutionPolicy(ctx)
+	inputLanguage := domain.DetectLanguage(userInput)
+	if policy.TranslationEnabled && inputLanguage != domain.LanguageEnglish {
+		englishTerm, err = s.tagRepo.TranslateText(ctx, userInput)
+	}
 
 	// Process translation result only if successful and different from original
 	if err == nil && englishTerm != "" && domain.NormalizeText(englishTerm) != normalizedInput {
@@ -103,25 +117,7 @@ func (s *tagServiceV2) ResolveTag(ctx context.Context, userInput string) (*domai
 
 		if err == nil && canonicalEng != nil {
 			fmt.Printf("[RESOLVE_TAG] ✓ Layer 1.5 HIT: Found canonical via translation '%s'\n", canonicalEng.DisplayName)
-
-			// Auto-create alias for original input to avoid future translation costs
-			// Use original input's embedding (not translated term) for future semantic search
-			embeddingSlice, embErr := s.tagRepo.GetEmbeddingForText(ctx, userInput)
-			if embErr == nil {
-				embedding := pgvector.NewVector(embeddingSlice)
-				newAlias, aliasErr := domain.NewTagAlias(userInput, canonicalEng.ID, embedding, 1.0)
-				if aliasErr != nil {
-					fmt.Printf("[RESOLVE_TAG] ⚠ Warning: Failed to create alias: %v\n", aliasErr)
-				} else {
-					// Save alias to optimize future lookups (next time will hit Layer 1)
-					if createErr := s.tagRepo.CreateAlias(ctx, newAlias); createErr != nil {
-						// Log warning but don't fail request - still return found canonical
-						fmt.Printf("[RESOLVE_TAG] ⚠ Warning: Failed to save translation alias: %v\n", createErr)
-					} else {
-						fmt.Printf("[RESOLVE_TAG] ✓ Created translation alias '%s' -> '%s'\n", userInput, canonicalEng.DisplayName)
-					}
-				}
-			}
+			s.linkTranslationAlias(ctx, userInput, canonicalEng, precomputed, policy)
 
 			fmt.Printf("[RESOLVE_TAG] ========================================\n\n")
 			return canonicalEng, userInput, false, nil
@@ -131,38 +127,61 @@ func (s *tagServiceV2) ResolveTag(ctx context.Context, userInput string) (*domai
 	} else if err != nil {
 		fmt.Printf("[RESOLVE_TAG] ⚠ Translation failed: %v (continuing to Layer 2)\n", err)
 	} else {
-		fmt.Printf("[RESOLVE_TAG]   Skipped: Input already in English or translation returned same term\n")
+		fmt.Printf("[RESOLVE_TAG]   Skipped: Translation disabled (%v), input already in English (detected: %s) or translation returned same term\n", !policy.TranslationEnabled, inputLanguage)
+	}
+
+	canonical, matchedAlias, decision, err := s.resolveByEmbedding(ctx, userInput, normalizedInput, precomputed, policy)
+	if err != nil {
+		return nil, "", false, err
 	}
+	return canonical, matchedAlias, decision.CreatesTag(), nil
+}
 
+// linkTranslationAlias saves userInput as an alias of the canonical its translation matched (Layer 1.5 hit)
+// Best effort: failures are logged, the canonical is still used
+// Uses the original input's embedding (not the translated term) for future semantic search
+func (s *tagServiceV2) linkTranslationAlias(ctx context.Context, userInput string, canonicalEng *domain.CanonicalTag, precomputed []float32, policy domain.ResolutionPolicySettings) {
+	embeddingSlice, embErr := s.embeddingFor(ctx, userInput, precomputed)
+	if embErr != nil {
+		return
+	}
+	embedding := pgvector.NewVector(embeddingSlice)
+	newAlias, aliasErr := domain.NewTagAlias(userInput, canonicalEng.ID, embedding, 1.0)
+	if aliasErr != nil {
+		fmt.Printf("[RESOLVE_TAG] ⚠ Warning: Failed to create alias: %v\n", aliasErr)
+		return
+	}
+	newAlias.IsReviewed = policy.AutoApproveAliases
+	// Save alias to optimize future lookups (next time will hit Layer 1)
+	if createErr := s.tagRepo.CreateAlias(ctx, newAlias); createErr != nil {
+		// Log warning but don't fail request - still return found canonical
+		fmt.Printf("[RESOLVE_TAG] ⚠ Warning: Failed to save translation alias: %v\n", createErr)
+		return
+	}
+	fmt.Printf("[RESOLVE_TAG] ✓ Created translation alias '%s' -> '%s'\n", userInput, canonicalEng.DisplayName)
+}
+
+// resolveByEmbedding runs Layers 2-4 for an input that missed Layers 1 and 1.5
+// Returns the decision taken so callers can report which layer produced the tag
+func (s *tagServiceV2) resolveByEmbedding(ctx context.Context, userInput, normalizedInput string, precomputed []float32, policy domain.ResolutionPolicySettings) (*domain.CanonicalTag, string, domain.ResolveDecision, error) {
 	// ============================================================
 	// Layer 2: Embedding Generation
 	// Cost: ~500ms, ~$0.0001 (OpenAI API call)
 	// ============================================================
 	fmt.Printf("[RESOLVE_TAG] Layer 2: Generating embedding...\n")
 
-	embeddingSlice, err := s.tagRepo.GetEmbeddingForText(ctx, userInput)
+	embeddingSlice, err := s.embeddingFor(ctx, userInput, precomputed)
 	if err != nil {
-		// OpenAI unavailable → Create new canonical without semantic check
 		fmt.Printf("[RESOLVE_TAG] ⚠ Layer 2 FAILED: OpenAI unavailable (%v)\n", err)
-		fmt.Printf("[RESOLVE_TAG]   Fallback: Creating new canonical without semantic check\n")
-
-		newCanonical, canonicalErr := domain.NewCanonicalTag(userInput)
-		if canonicalErr != nil {
-			return nil, "", false, fmt.Errorf("failed to create canonical (invalid input): %w", canonicalErr)
-		}
-		// Note: Using empty embedding since OpenAI failed. This will be backfilled later.
-		newAlias, aliasErr := domain.NewInitialTagAlias(userInput, pgvector.Vector{}, 1.0)
-		if aliasErr != nil {
-			return nil, "", false, fmt.Errorf("failed to create alias (validation failed): %w", aliasErr)
-		}
-
-		if createErr := s.tagRepo.CreateCanonicalTag(ctx, newCanonical, newAlias); createErr != nil {
-			return nil, "", false, fmt.Errorf("failed to create canonical (no OpenAI): %w", createErr)
+		if !policy.CreateWithoutEmbedding {
+			fmt.Printf("[RESOLVE_TAG] ========================================\n\n")
+			return nil, "", "", fmt.Errorf("%w: %w", domain.ErrEmbeddingUnavailable, err)
 		}
 
-		fmt.Printf("[RESOLVE_TAG] ✓ Created new canonical '%s' (ID: %s)\n", newCanonical.DisplayName, newCanonical.ID)
-		fmt.Printf("[RESOLVE_TAG] ========================================\n\n")
-		return newCanonical, userInput, true, nil
+		// OpenAI unavailable → Create new canonical without semantic check
+		// Note: Using empty embedding since OpenAI failed. This will be backfilled later.
+		fmt.Printf("[RESOLVE_TAG]   Fallback: Creating new canonical without semantic check\n")
+		return s.createCanonicalWithAlias(ctx, userInput, normalizedInput, pgvector.Vector{}, domain.ResolveDecisionCreateNoEmbed)
 	}
 
 	embedding := pgvector.NewVector(embeddingSlice)
@@ -172,11 +191,12 @@ func (s *tagServiceV2) ResolveTag(ctx context.Context, userInput string) (*domai
 	// Layer 3: Semantic Search (Vector Similarity)
 	// Cost: ~50ms, $0 (uses cached embeddings in DB)
 	// ============================================================
-	fmt.Printf("[RESOLVE_TAG] Layer 3: Semantic search (threshold: %.2f)...\n", AUTO_MERGE_THRESHOLD)
+	threshold := policy.DistanceFor(domain.DetectLanguage(userInput))
+	fmt.Printf("[RESOLVE_TAG] Layer 3: Semantic search (threshold: %.2f, strategy: %s)...\n", threshold, policy.MatchStrategy)
 
-	closestCanonical, similarityScore, err := s.tagRepo.GetClosestCanonical(ctx, embedding, AUTO_MERGE_THRESHOLD)
+	closestCanonical, similarityScore, err := s.tagRepo.GetClosestCanonical(ctx, embedding, threshold, policy.MatchStrategy)
 	if err != nil {
-		return nil, "", false, fmt.Errorf("Layer 3 failed: %w", err)
+		return nil, "", "", fmt.Errorf("Layer 3 failed: %w", err)
 	}
 
 	// ============================================================
@@ -185,7 +205,7 @@ func (s *tagServiceV2) ResolveTag(ctx context.Context, userInput string) (*domai
 
 	// Scenario A: Match Found (Score >= Threshold)
 	// Action: Create new alias → Link to existing canonical
-	if closestCanonical != nil {
+	if closestCanonical != nil && policy.MergeMode == domain.MergeModeAuto {
 		fmt.Printf("[RESOLVE_TAG] ✓ Layer 3 HIT: Found similar canonical '%s' (score: %.2f%%)\n",
 			closestCanonical.DisplayName, similarityScore*100)
 
@@ -196,39 +216,245 @@ func (s *tagServiceV2) ResolveTag(ctx context.Context, userInput string) (*domai
 		// Create new alias pointing to existing canonical
 		newAlias, aliasErr := domain.NewTagAlias(userInput, closestCanonical.ID, embedding, similarityScore)
 		if aliasErr != nil {
-			return nil, "", false, fmt.Errorf("failed to create alias (validation failed): %w", aliasErr)
+			return nil, "", "", fmt.Errorf("failed to create alias (validation failed): %w", aliasErr)
 		}
+		newAlias.IsReviewed = policy.AutoApproveAliases
 		if err := s.tagRepo.CreateAlias(ctx, newAlias); err != nil {
-			return nil, "", false, fmt.Errorf("failed to create alias: %w", err)
+			if errors.Is(err, domain.ErrAliasTaken) {
+				return s.resolveRaceWinner(ctx, normalizedInput)
+			}
+			return nil, "", "", fmt.Errorf("failed to create alias: %w", err)
 		}
 
 		fmt.Printf("[RESOLVE_TAG] ✓ Alias created successfully\n")
 		fmt.Printf("[RESOLVE_TAG] ========================================\n\n")
-		return closestCanonical, userInput, false, nil
+		return closestCanonical, userInput, domain.ResolveDecisionAutoMerge, nil
+	}
+
+	// Scenario A': Match Found in suggest mode
+	// Action: Create new canonical, let mods decide on the merge via the duplicates queue
+	if closestCanonical != nil {
+		fmt.Printf("[RESOLVE_TAG] ✓ Layer 3 HIT: Found similar canonical '%s' (score: %.2f%%)\n",
+			closestCanonical.DisplayName, similarityScore*100)
+		fmt.Printf("[RESOLVE_TAG] Layer 4: SUGGEST MERGE (policy merge_mode=suggest)\n")
+
+		canonical, matchedAlias, decision, err := s.createCanonicalWithAlias(ctx, userInput, normalizedInput, embedding, domain.ResolveDecisionSuggestMerge)
+		if err == nil && decision == domain.ResolveDecisionSuggestMerge {
+			s.suggestDuplicate(ctx, canonical, closestCanonical, similarityScore)
+		}
+		return canonical, matchedAlias, decision, err
 	}
 
 	// Scenario B: No Match (Score < Threshold)
 	// Action: Create new canonical + initial alias
 	fmt.Printf("[RESOLVE_TAG] ✗ Layer 3 MISS: No similar canonical found\n")
 	fmt.Printf("[RESOLVE_TAG] Layer 4: CREATE NEW (Scenario B)\n")
+	return s.createCanonicalWithAlias(ctx, userInput, normalizedInput, embedding, domain.ResolveDecisionCreateNew)
+}
+
+// createCanonicalWithAlias creates a new canonical named after userInput with its initial alias
+// decision is returned as is on success; a lost race returns the winner like a Layer 1 hit
+func (s *tagServiceV2) createCanonicalWithAlias(ctx context.Context, userInput, normalizedInput string, embedding pgvector.Vector, decision domain.ResolveDecision) (*domain.CanonicalTag, string, domain.ResolveDecision, error) {
 	fmt.Printf("[RESOLVE_TAG]   Action: Create new canonical '%s' + initial alias\n", userInput)
 
 	newCanonical, canonicalErr := domain.NewCanonicalTag(userInput)
 	if canonicalErr != nil {
-		return nil, "", false, fmt.Errorf("failed to create canonical (invalid input): %w", canonicalErr)
+		return nil, "", "", fmt.Errorf("failed to create canonical (invalid input): %w", canonicalErr)
 	}
 	newAlias, aliasErr := domain.NewInitialTagAlias(userInput, embedding, 1.0) // Score=1.0 for canonical
 	if aliasErr != nil {
-		return nil, "", false, fmt.Errorf("failed to create alias (validation failed): %w", aliasErr)
+		return nil, "", "", fmt.Errorf("failed to create alias (validation failed): %w", aliasErr)
 	}
 
 	if err := s.tagRepo.CreateCanonicalTag(ctx, newCanonical, newAlias); err != nil {
-		return nil, "", false, fmt.Errorf("failed to create canonical: %w", err)
+		if errors.Is(err, domain.ErrAliasTaken) {
+			return s.resolveRaceWinner(ctx, normalizedInput)
+		}
+		return nil, "", "", fmt.Errorf("failed to create canonical: %w", err)
 	}
 
 	fmt.Printf("[RESOLVE_TAG] ✓ New canonical created (ID: %s)\n", newCanonical.ID)
 	fmt.Printf("[RESOLVE_TAG] ========================================\n\n")
-	return newCanonical, userInput, true, nil
+	return newCanonical, userInput, decision, nil
+}
+
+// suggestDuplicate queues (created, closest) in the duplicates review queue (best effort)
+// Scores are provisional: the next duplicate detection scan recomputes them
+func (s *tagServiceV2) suggestDuplicate(ctx context.Context, created, closest *domain.CanonicalTag, similarity float64) {
+	distance := 2 * (1 - similarity)
+	candidate := &domain.TagDuplicateCandidate{
+		TagAID:            created.ID,
+		TagBID:            closest.ID,
+		Status:            domain.DuplicateStatusPending,
+		Source:            domain.DuplicateSourceResolve,
+		EmbeddingDistance: &distance,
+		SlugSimilarity:    slugSimilarity(created.Slug, closest.Slug),
+		MatchedAliasA:     created.DisplayName,
+		MatchedAliasB:     closest.DisplayName,
+	}
+	// Pairs are stored with TagAID < TagBID
+	if bytes.Compare(candidate.TagAID[:], candidate.TagBID[:]) > 0 {
+		candidate.TagAID, candidate.TagBID = candidate.TagBID, candidate.TagAID
+		candidate.MatchedAliasA, candidate.MatchedAliasB = candidate.MatchedAliasB, candidate.MatchedAliasA
+	}
+	candidate.Score = duplicateScore(candidate.EmbeddingDistance, candidate.SlugSimilarity, 0)
+
+	if err := s.tagRepo.AddDuplicateCandidate(ctx, candidate); err != nil {
+		fmt.Printf("[RESOLVE_TAG] ⚠ Warning: Failed to suggest duplicate '%s' ↔ '%s': %v\n", created.DisplayName, closest.DisplayName, err)
+		return
+	}
+	fmt.Printf("[RESOLVE_TAG] ✓ Suggested '%s' ↔ '%s' as duplicates for review\n", created.DisplayName, closest.DisplayName)
+}
+
+// resolveRaceWinner returns the canonical a concurrent request attached normalizedInput to
+// Called when our write lost the race (ErrAliasTaken): the result is the same as a Layer 1 hit
+// The translation/embedding work of the losing request is thrown away
+func (s *tagServiceV2) resolveRaceWinner(ctx context.Context, normalizedInput string) (*domain.CanonicalTag, string, domain.ResolveDecision, error) {
+	canonical, err := s.tagRepo.GetCanonicalByAlias(ctx, normalizedInput)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to re-read alias after concurrent create: %w", err)
+	}
+	if canonical == nil {
+		// The winner was removed in between (e.g. alias deleted) - let the caller retry
+		return nil, "", "", fmt.Errorf("%w: '%s' was taken then removed concurrently", domain.ErrAliasTaken, normalizedInput)
+	}
+
+	fmt.Printf("[RESOLVE_TAG] ✓ Concurrent request created '%s' first → using canonical '%s' (ID: %s)\n",
+		normalizedInput, canonical.DisplayName, canonical.ID)
+	fmt.Printf("[RESOLVE_TAG] ========================================\n\n")
+	return canonical, normalizedInput, domain.ResolveDecisionExactMatch, nil
+}
+
+// ExplainResolveTag mirrors ResolveTag layer by layer but never writes to the DB
+// Mods use it to predict whether an input will hit an existing tag, auto-merge, or create a new canonical
+func (s *tagServiceV2) ExplainResolveTag(ctx context.Context, userInput string, topK int) (*dto.TagResolveTraceResponse, error) {
+	if domain.NormalizeText(userInput) == "" {
+		return nil, fmt.Errorf("%w: input cannot be empty", domain.ErrInvalidRequest)
+	}
+	if topK < 1 {
+		topK = 5
+	}
+
+	trace := &dto.TagResolveTraceResponse{
+		Input:          userInput,
+		NormalizedText: domain.NormalizeText(userInput),
+		NearestAliases: []dto.NearestAliasResponse{},
+		DryRun:         true,
+	}
+	policy := s.resolutionPolicy(ctx)
+	threshold := policy.DistanceFor(domain.DetectLanguage(userInput))
+	trace.Threshold = threshold
+	trace.MatchStrategy = string(policy.MatchStrategy)
+	addLayer := func(layer, name, result, detail string) {
+		trace.Layers = append(trace.Layers, dto.TagResolveLayerTrace{Layer: layer, Name: name, Result: result, Detail: detail})
+	}
+
+	// Layer 1: Exact Match
+	canonical, err := s.tagRepo.GetCanonicalByAlias(ctx, trace.NormalizedText)
+	if err != nil {
+		return nil, fmt.Errorf("Layer 1 failed: %w", err)
+	}
+	if canonical != nil {
+		addLayer("1", "exact_match", "hit", fmt.Sprintf("alias '%s' already belongs to '%s'", trace.NormalizedText, canonical.DisplayName))
+		trace.Decision = string(domain.ResolveDecisionExactMatch)
+		trace.DecisionTag = s.toCanonicalTagResponse(canonical)
+		return trace, nil
+	}
+	addLayer("1", "exact_match", "miss", "no alias with this normalized text")
+
+	// Layer 1.5: Translation (skipped for English input or when disabled by the policy)
+	var englishTerm string
+	isEnglish := domain.DetectLanguage(userInput) == domain.LanguageEnglish
+	if policy.TranslationEnabled && !isEnglish {
+		englishTerm, err = s.tagRepo.TranslateText(ctx, userInput)
+	}
+	switch {
+	case !policy.TranslationEnabled:
+		addLayer("1.5", "translation", "skipped", "translation disabled by resolution policy")
+	case isEnglish:
+		addLayer("1.5", "translation", "skipped", "input detected as English")
+	case err != nil:
+		addLayer("1.5", "translation", "error", err.Error())
+	case englishTerm == "" || domain.NormalizeText(englishTerm) == trace.NormalizedText:
+		addLayer("1.5", "translation", "skipped", "input already in English or translation unavailable")
+	default:
+		trace.TranslatedText = &englishTerm
+		canonicalEng, lookupErr := s.tagRepo.GetCanonicalByAlias(ctx, domain.NormalizeText(englishTerm))
+		if lookupErr == nil && canonicalEng != nil {
+			addLayer("1.5", "translation", "hit", fmt.Sprintf("translated to '%s' which belongs to '%s'; alias '%s' would be created", englishTerm, canonicalEng.DisplayName, userInput))
+			trace.Decision = string(domain.ResolveDecisionTranslationMatch)
+			trace.DecisionTag = s.toCanonicalTagResponse(canonicalEng)
+			return trace, nil
+		}
+		addLayer("1.5", "translation", "miss", fmt.Sprintf("translated to '%s' but no alias matches it", englishTerm))
+	}
+
+	// Layer 2: Embedding
+	embeddingSlice, err := s.tagRepo.GetEmbeddingForText(ctx, userInput)
+	if err != nil {
+		addLayer("2", "embedding", "error", err.Error())
+		if !policy.CreateWithoutEmbedding {
+			addLayer("4", "decision", "decision", "OpenAI unavailable: the request would fail (create_without_embedding is off)")
+			trace.Decision = string(domain.ResolveDecisionUnavailable)
+			return trace, nil
+		}
+		addLayer("4", "decision", "decision", "OpenAI unavailable: a new canonical would be created without semantic check")
+		trace.Decision = string(domain.ResolveDecisionCreateNoEmbed)
+		return trace, nil
+	}
+	addLayer("2", "embedding", "hit", fmt.Sprintf("%d dims", len(embeddingSlice)))
+
+	// Layer 3: Nearest aliases
+	vector := pgvector.NewVector(embeddingSlice)
+	matches, err := s.tagRepo.GetNearestAliases(ctx, vector, topK)
+	if err != nil {
+		return nil, fmt.Errorf("Layer 3 failed: %w", err)
+	}
+	for _, m := range matches {
+		trace.NearestAliases = append(trace.NearestAliases, dto.NearestAliasResponse{
+			AliasID:         m.AliasID.String(),
+			Alias:           m.RawText,
+			CanonicalID:     m.CanonicalTagID.String(),
+			CanonicalName:   m.CanonicalName,
+			Distance:        m.Distance,
+			Similarity:      domain.DistanceToSimilarity(m.Distance),
+			WithinThreshold: m.Distance < threshold,
+		})
+	}
+
+	// Layer 4: Decision (same rule as GetClosestCanonical: nearest canonical under the policy strategy with distance < threshold)
+	nearest, err := s.tagRepo.GetNearestCanonicals(ctx, vector, policy.MatchStrategy, 1)
+	if err != nil {
+		return nil, fmt.Errorf("Layer 3 failed: %w", err)
+	}
+	if len(nearest) > 0 && nearest[0].Distance < threshold {
+		best := nearest[0]
+		addLayer("3", "semantic_search", "hit", fmt.Sprintf("nearest canonical '%s' at %s distance %.4f < %.2f", best.CanonicalName, policy.MatchStrategy, best.Distance, threshold))
+		if policy.MergeMode == domain.MergeModeSuggest {
+			addLayer("4", "decision", "decision", fmt.Sprintf("a new canonical '%s' would be created and suggested as duplicate of '%s'", userInput, best.CanonicalName))
+			trace.Decision = string(domain.ResolveDecisionSuggestMerge)
+		} else {
+			addLayer("4", "decision", "decision", fmt.Sprintf("alias '%s' would be auto-merged into '%s'", userInput, best.CanonicalName))
+			trace.Decision = string(domain.ResolveDecisionAutoMerge)
+		}
+
+		target, err := s.tagRepo.GetCanonicalByID(ctx, best.CanonicalTagID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load matched canonical: %w", err)
+		}
+		trace.DecisionTag = s.toCanonicalTagResponse(target)
+		return trace, nil
+	}
+
+	if len(nearest) > 0 {
+		addLayer("3", "semantic_search", "miss", fmt.Sprintf("nearest canonical '%s' at %s distance %.4f >= %.2f", nearest[0].CanonicalName, policy.MatchStrategy, nearest[0].Distance, threshold))
+	} else {
+		addLayer("3", "semantic_search", "miss", "no embeddings to compare against")
+	}
+	addLayer("4", "decision", "decision", fmt.Sprintf("a new canonical '%s' would be created", userInput))
+	trace.Decision = string(domain.ResolveDecisionCreateNew)
+
+	return trace, nil
 }
 
 // ============================================================
@@ -246,7 +472,7 @@ func (s *tagServiceV2) GetCanonicalTagByID(ctx context.Context, id string) (*dto
 		return nil, fmt.Errorf("canonical tag not found: %w", err)
 	}
 
-	return s.toCanonicalTagResponse(canonical), nil
+	return s.tagResponseWithDisplayNames(ctx, canonical)
 }
 
 func (s *tagServiceV2) ListCanonicalTags(ctx context.Context, req dto.TagListRequest) (*dto.TagListResponse, error) {
@@ -259,7 +485,7 @@ func (s *tagServiceV2) ListCanonicalTags(ctx context.Context, req dto.TagListReq
 
 	// If query provided, search instead of list
 	if req.Query != "" {
-		canonicals, err := s.tagRepo.SearchCanonicalTags(ctx, req.Query, req.Limit, req.ApprovedOnly)
+		canonicals, err := s.tagRepo.SearchCanonicalTags(ctx, req.Query, req.Limit, req.ApprovedOnly, s.resolutionPolicy(ctx).MatchStrategy)
 		if err != nil {
 			return nil, fmt.Errorf("failed to search canonical tags: %w", err)
 		}
@@ -268,6 +494,7 @@ func (s *tagServiceV2) ListCanonicalTags(ctx context.Context, req dto.TagListReq
 		for i, canonical := range canonicals {
 			tagResponses[i] = *s.toCanonicalTagResponse(&canonical)
 		}
+		s.localizeTagResponses(ctx, tagResponsePointers(tagResponses)...)
 
 		return &dto.TagListResponse{
 			Data: tagResponses,
@@ -280,7 +507,12 @@ func (s *tagServiceV2) ListCanonicalTags(ctx context.Context, req dto.TagListReq
 		}, nil
 	}
 
-	canonicals, total, err := s.tagRepo.ListCanonicalTags(ctx, req.Page, req.Limit, req.ApprovedOnly)
+	if req.Days < 1 {
+		req.Days = TRENDING_DEFAULT_DAYS
+	}
+	growthSince := time.Now().AddDate(0, 0, -req.Days)
+
+	canonicals, total, err := s.tagRepo.ListCanonicalTags(ctx, req.Page, req.Limit, req.ApprovedOnly, domain.TagSort(req.Sort), growthSince)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list canonical tags: %w", err)
 	}
@@ -289,6 +521,7 @@ func (s *tagServiceV2) ListCanonicalTags(ctx context.Context, req dto.TagListReq
 	for i, canonical := range canonicals {
 		tagResponses[i] = *s.toCanonicalTagResponse(&canonical)
 	}
+	s.localizeTagResponses(ctx, tagResponsePointers(tagResponses)...)
 
 	totalPages := int(math.Ceil(float64(total) / float64(req.Limit)))
 
@@ -308,7 +541,7 @@ func (s *tagServiceV2) SearchCanonicalTags(ctx context.Context, query string, li
 		limit = 20
 	}
 
-	canonicals, err := s.tagRepo.SearchCanonicalTags(ctx, query, limit, approvedOnly)
+	canonicals, err := s.tagRepo.SearchCanonicalTags(ctx, query, limit, approvedOnly, s.resolutionPolicy(ctx).MatchStrategy)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search canonical tags: %w", err)
 	}
@@ -317,6 +550,7 @@ func (s *tagServiceV2) SearchCanonicalTags(ctx context.Context, query string, li
 	for i, canonical := range canonicals {
 		tagResponses[i] = *s.toCanonicalTagResponse(&canonical)
 	}
+	s.localizeTagResponses(ctx, tagResponsePointers(tagResponses)...)
 
 	return tagResponses, nil
 }
@@ -399,6 +633,7 @@ func (s *tagServiceV2) GetVideoCanonicalTags(ctx context.Context, videoID string
 	for i, canonical := range canonicals {
 		tagResponses[i] = *s.toCanonicalTagResponse(&canonical)
 	}
+	s.localizeTagResponses(ctx, tagResponsePointers(tagResponses)...)
 
 	return tagResponses, nil
 }
@@ -409,20 +644,41 @@ func (s *tagServiceV2) GetVideoCanonicalTags(ctx context.Context, videoID string
 
 // toCanonicalTagResponse converts domain.CanonicalTag to dto.TagResponse
 func (s *tagServiceV2) toCanonicalTagResponse(canonical *domain.CanonicalTag) *dto.TagResponse {
-	// Map aliases to string array (RawText field)
+	// Map aliases to string array (RawText field), also grouped by language
 	aliases := make([]string, 0, len(canonical.Aliases))
+	var aliasesByLanguage map[string][]string
 	for _, alias := range canonical.Aliases {
 		// Skip if alias matches the canonical name to avoid duplication
 		if alias.RawText != canonical.DisplayName {
 			aliases = append(aliases, alias.RawText)
+
+			if aliasesByLanguage == nil {
+				aliasesByLanguage = make(map[string][]string)
+			}
+			language := alias.Language
+			if language == "" {
+				language = domain.LanguageUnknown
+			}
+			aliasesByLanguage[language] = append(aliasesByLanguage[language], alias.RawText)
 		}
 	}
 
+	var parentID *string
+	if canonical.ParentID != nil {
+		id := canonical.ParentID.String()
+		parentID = &id
+	}
+
 	return &dto.TagResponse{
-		ID:         canonical.ID.String(),
-		Name:       canonical.DisplayName,
-		IsApproved: canonical.IsApproved,
-		Aliases:    aliases,
+		ID:                canonical.ID.String(),
+		Name:              canonical.DisplayName,
+		Slug:              canonical.Slug,
+		IsApproved:        canonical.IsApproved,
+		ParentID:          parentID,
+		VideoCount:        canonical.VideoCount,
+		AliasCount:        canonical.AliasCount,
+		Aliases:           aliases,
+		AliasesByLanguage: aliasesByLanguage,
 	}
 }
 
@@ -437,7 +693,8 @@ func (s *tagServiceV2) toCanonicalTagResponse(canonical *domain.CanonicalTag) *d
 // 3. Move all aliases from source to target
 // 4. Update video relationships
 // 5. Delete source canonical tag
-func (s *tagServiceV2) MergeTags(ctx context.Context, req dto.MergeTagsRequest) (*dto.MergeTagsResponse, error) {
+// 6. Record merge log (mergedBy) so the merge can be undone
+func (s *tagServiceV2) MergeTags(ctx context.Context, req dto.MergeTagsRequest, mergedBy uuid.UUID) (*dto.MergeTagsResponse, error) {
 	// Parse UUIDs
 	sourceUUID, err := uuid.Parse(req.SourceID)
 	if err != nil {
@@ -466,20 +723,27 @@ func (s *tagServiceV2) MergeTags(ctx context.Context, req dto.MergeTagsRequest)
 	}
 
 	// Perform merge operation (atomic transaction)
-	mergedAliasCount, err := s.tagRepo.MergeTags(ctx, sourceUUID, targetUUID)
+	var mergedByPtr *uuid.UUID
+	if mergedBy != uuid.Nil {
+		mergedByPtr = &mergedBy
+	}
+	mergeLog, err := s.tagRepo.MergeTags(ctx, sourceUUID, targetUUID, mergedByPtr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to merge tags: %w", err)
 	}
 
+	// Reload target so counters include the merged aliases and videos
+	if merged, err := s.tagRepo.GetCanonicalByID(ctx, targetUUID); err == nil {
+		targetTag = merged
+	}
+
 	// Build response
 	return &dto.MergeTagsResponse{
-		TargetTag: dto.TagResponse{
-			ID:         targetTag.ID.String(),
-			Name:       targetTag.DisplayName,
-			IsApproved: targetTag.IsApproved,
-		},
-		MergedAliasCount: mergedAliasCount,
+		TargetTag:        *s.toCanonicalTagResponse(targetTag),
+		MergedAliasCount: mergeLog.MovedAliasCount(),
 		SourceTagDeleted: true,
+		MergeID:          mergeLog.ID,
+		MergedVideoCount: len(mergeLog.Videos),
 	}, nil
 }
 
@@ -502,9 +766,5 @@ func (s *tagServiceV2) UpdateTagApproval(ctx context.Context, tagID string, isAp
 		return nil, fmt.Errorf("failed to update tag approval: %w", err)
 	}
 
-	return &dto.TagResponse{
-		ID:         tag.ID.String(),
-		Name:       tag.DisplayName,
-		IsApproved: tag.IsApproved,
-	}, nil
+	return s.toCanonicalTagResponse(tag), nil
 }
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ============================================================
+// Taxonomy Export / Import Implementation
+// ============================================================
+
+// ExportTaxonomy exports every canonical tag with its aliases as a versioned taxonomy file
+// Embeddings are only included on request (they make the file ~30x larger)
+func (s *tagServiceV2) ExportTaxonomy(ctx context.Context, includeEmbeddings bool) (*dto.TaxonomyFile, error) {
+	canonicals, err := s.tagRepo.ListAllCanonicalTags(ctx, includeEmbeddings)
+	if err != nil {
+		return nil, err
+	}
+
+	slugByID := make(map[uuid.UUID]string, len(canonicals))
+	for _, c := range canonicals {
+		slugByID[c.ID] = c.Slug
+	}
+
+	tags := make([]dto.TaxonomyTagItem, len(canonicals))
+	for i, c := range canonicals {
+		item := dto.TaxonomyTagItem{
+			Slug:        c.Slug,
+			DisplayName: c.DisplayName,
+			IsApproved:  c.IsApproved,
+			Aliases:     make([]dto.TaxonomyAliasItem, len(c.Aliases)),
+		}
+		if c.ParentID != nil {
+			item.ParentSlug = slugByID[*c.ParentID]
+		}
+		for j, a := range c.Aliases {
+			alias := dto.TaxonomyAliasItem{
+				Text:       a.RawText,
+				Language:   a.Language,
+				IsReviewed: a.IsReviewed,
+			}
+			if includeEmbeddings {
+				alias.Embedding = a.Embedding.Slice()
+			}
+			item.Aliases[j] = alias
+		}
+		tags[i] = item
+	}
+
+	return &dto.TaxonomyFile{
+		Format:     domain.TaxonomyFormat,
+		Version:    domain.TaxonomyFormatVersion,
+		ExportedAt: time.Now().UTC(),
+		Tags:       tags,
+	}, nil
+}
+
+// ImportTaxonomy upserts a taxonomy file by slug and normalized alias text
+// Aliases imported without embedding get one generated after the import commits;
+// if OpenAI is unavailable they stay without embedding (exact match still works)
+func (s *tagServiceV2) ImportTaxonomy(ctx context.Context, file dto.TaxonomyFile, dryRun bool) (*dto.TaxonomyImportResponse, error) {
+	if file.Format != domain.TaxonomyFormat {
+		return nil, fmt.Errorf("%w: unknown format '%s' (expected '%s')", domain.ErrInvalidRequest, file.Format, domain.TaxonomyFormat)
+	}
+	if file.Version < 1 || file.Version > domain.TaxonomyFormatVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d (latest is %d)", domain.ErrInvalidRequest, file.Version, domain.TaxonomyFormatVersion)
+	}
+
+	tags := make([]domain.TaxonomyTag, len(file.Tags))
+	for i, t := range file.Tags {
+		aliases := make([]domain.TaxonomyAlias, len(t.Aliases))
+		for j, a := range t.Aliases {
+			aliases[j] = domain.TaxonomyAlias{
+				Text:       a.Text,
+				Language:   a.Language,
+				IsReviewed: a.IsReviewed,
+				Embedding:  a.Embedding,
+			}
+		}
+		tags[i] = domain.TaxonomyTag{
+			Slug:        t.Slug,
+			DisplayName: t.DisplayName,
+			IsApproved:  t.IsApproved,
+			ParentSlug:  t.ParentSlug,
+			Aliases:     aliases,
+		}
+	}
+
+	result, err := s.tagRepo.ImportTaxonomy(ctx, tags, dryRun)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import taxonomy: %w", err)
+	}
+
+	response := &dto.TaxonomyImportResponse{
+		DryRun:           dryRun,
+		TagsCreated:      result.TagsCreated,
+		TagsUpdated:      result.TagsUpdated,
+		TagsUnchanged:    result.TagsUnchanged,
+		AliasesCreated:   result.AliasesCreated,
+		AliasesUnchanged: result.AliasesUnchanged,
+		ParentsSet:       result.ParentsSet,
+		Conflicts:        make([]dto.TaxonomyConflictResponse, len(result.Conflicts)),
+	}
+	for i, c := range result.Conflicts {
+		response.Conflicts[i] = dto.TaxonomyConflictResponse{
+			Kind:         c.Kind,
+			Slug:         c.Slug,
+			Alias:        c.Alias,
+			ExistingSlug: c.ExistingSlug,
+			Message:      c.Message,
+		}
+	}
+
+	if len(result.MissingEmbeddings) > 0 {
+		response.EmbeddingsGenerated = s.regenerateAliasEmbeddings(ctx, result.MissingEmbeddings)
+		response.EmbeddingsMissing = len(result.MissingEmbeddings) - response.EmbeddingsGenerated
+	}
+
+	fmt.Printf("[TAXONOMY] Import (dry_run=%v): %d tags created, %d updated, %d aliases created, %d conflicts\n",
+		dryRun, result.TagsCreated, result.TagsUpdated, result.AliasesCreated, len(result.Conflicts))
+
+	return response, nil
+}
+
+// regenerateAliasEmbeddings generates and stores embeddings for imported aliases
+// Returns the number of aliases that got an embedding (0 if OpenAI is unavailable)
+func (s *tagServiceV2) regenerateAliasEmbeddings(ctx context.Context, aliases []domain.AliasText) int {
+	texts := make([]string, len(aliases))
+	ids := make([]uuid.UUID, len(aliases))
+	for i, a := range aliases {
+		texts[i] = a.RawText
+		ids[i] = a.ID
+	}
+
+	embeddings, err := s.tagRepo.GetEmbeddingsForTexts(ctx, texts)
+	if err != nil {
+		fmt.Printf("[TAXONOMY] ⚠ Embedding regeneration failed: %v (%d aliases left without embedding)\n", err, len(aliases))
+		return 0
+	}
+
+	if err := s.tagRepo.SetAliasEmbeddings(ctx, ids, embeddings); err != nil {
+		fmt.Printf("[TAXONOMY] ⚠ Failed to store embeddings: %v\n", err)
+		return 0
+	}
+
+	return len(aliases)
+}
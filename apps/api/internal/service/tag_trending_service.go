@@ -0,0 +1,50 @@
+package service
+
+import (
+	"api/internal/dto"
+	"context"
+	"fmt"
+	"time"
+)
+
+// Trending window defaults
+const (
+	TRENDING_DEFAULT_DAYS  = 7
+	TRENDING_DEFAULT_LIMIT = 20
+)
+
+// ============================================================
+// Trending Tags Implementation
+// ============================================================
+
+// GetTrendingTags returns the tags with the most videos tagged in the last req.Days days
+func (s *tagServiceV2) GetTrendingTags(ctx context.Context, req dto.TrendingTagsRequest) ([]dto.TrendingTagResponse, error) {
+	if req.Days < 1 {
+		req.Days = TRENDING_DEFAULT_DAYS
+	}
+	if req.Limit < 1 {
+		req.Limit = TRENDING_DEFAULT_LIMIT
+	}
+
+	since := time.Now().AddDate(0, 0, -req.Days)
+	trending, err := s.tagRepo.GetTrendingTags(ctx, since, req.Limit, req.ApprovedOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trending tags: %w", err)
+	}
+
+	results := make([]dto.TrendingTagResponse, len(trending))
+	for i, t := range trending {
+		results[i] = dto.TrendingTagResponse{
+			Tag:              *s.toCanonicalTagResponse(&t.Tag),
+			RecentVideoCount: t.RecentVideoCount,
+		}
+	}
+
+	tags := make([]*dto.TagResponse, len(results))
+	for i := range results {
+		tags[i] = &results[i].Tag
+	}
+	s.localizeTagResponses(ctx, tags...)
+
+	return results, nil
+}
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"api/internal/helper"
+	"api/internal/infrastructure"
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// videoImportTimeout bounds one import job (large channels need several hundred API calls)
+const videoImportTimeout = 2 * time.Hour
+
+// StartVideoImport validates the request, stores a queued job and runs it in the background
+func (s *videoService) StartVideoImport(ctx context.Context, req dto.CreateVideoImportRequest, requestedBy uuid.UUID) (*dto.VideoImportJobResponse, error) {
+	job := &domain.VideoImportJob{
+		MaxVideos:   req.MaxVideos,
+		Status:      domain.VideoImportQueued,
+		RequestedBy: requestedBy,
+		Errors:      []string{},
+	}
+
+	sources := 0
+	if req.ChannelID != "" {
+		sources++
+		job.Source, job.SourceID = domain.VideoImportChannel, req.ChannelID
+	}
+	if req.PlaylistID != "" {
+		sources++
+		job.Source, job.SourceID = domain.VideoImportPlaylist, req.PlaylistID
+	}
+	if len(req.URLs) > 0 {
+		sources++
+		job.Source = domain.VideoImportURLs
+	}
+	if sources != 1 {
+		return nil, fmt.Errorf("%w: set exactly one of channel_id, playlist_id or urls", domain.ErrInvalidImport)
+	}
+
+	switch job.Source {
+	case domain.VideoImportURLs:
+		// Parse up front so a typo fails the request instead of the job
+		seen := make(map[string]bool, len(req.URLs))
+		for _, raw := range req.URLs {
+			id, ok := helper.ParseYouTubeID(raw)
+			if !ok {
+				return nil, fmt.Errorf("%w: '%s'", domain.ErrInvalidYouTubeInput, raw)
+			}
+			if !seen[id] {
+				seen[id] = true
+				job.VideoIDs = append(job.VideoIDs, id)
+			}
+		}
+		if job.MaxVideos > 0 && len(job.VideoIDs) > job.MaxVideos {
+			job.VideoIDs = job.VideoIDs[:job.MaxVideos]
+		}
+		job.Total = len(job.VideoIDs)
+	default:
+		// Channels and playlists can only be listed through the API
+		if s.youtube == nil {
+			return nil, domain.ErrYouTubeUnavailable
+		}
+	}
+
+	if err := s.repo.CreateImportJob(ctx, job); err != nil {
+		return nil, err
+	}
+
+	response := toVideoImportJobResponse(job) // Built before the job goroutine starts mutating job
+	go s.runVideoImport(job)
+
+	return response, nil
+}
+
+// GetVideoImport returns the progress of an import job
+func (s *videoService) GetVideoImport(ctx context.Context, id string) (*dto.VideoImportJobResponse, error) {
+	jobID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid job ID", domain.ErrInvalidImport)
+	}
+
+	job, err := s.repo.GetImportJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return toVideoImportJobResponse(job), nil
+}
+
+// ListVideoImports returns import jobs, newest first
+func (s *videoService) ListVideoImports(ctx context.Context, page, limit int) (*dto.VideoImportJobListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 20
+	}
+
+	jobs, total, err := s.repo.ListImportJobs(ctx, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.VideoImportJobResponse, len(jobs))
+	for i := range jobs {
+		items[i] = *toVideoImportJobResponse(&jobs[i])
+	}
+
+	return &dto.VideoImportJobListResponse{
+		Data: items,
+		Pagination: dto.PaginationMetadata{
+			Page:       page,
+			Limit:      limit,
+			TotalItems: total,
+			TotalPages: int(math.Ceil(float64(total) / float64(limit))),
+		},
+	}, nil
+}
+
+// FailInterruptedVideoImports marks jobs that were running when the server stopped as failed
+// Called once at startup: jobs run in-process and cannot resume
+func (s *videoService) FailInterruptedVideoImports(ctx context.Context) error {
+	count, err := s.repo.FailInterruptedImportJobs(ctx)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		slog.Warn("Marked interrupted video imports as failed", "count", count)
+	}
+	return nil
+}
+
+// runVideoImport executes a job to completion, saving progress after every batch
+// The job stops early (and is marked failed) when the server shuts down
+func (s *videoService) runVideoImport(job *domain.VideoImportJob) {
+	ctx, cancel := context.WithTimeout(s.jobCtx, videoImportTimeout)
+	defer cancel()
+
+	startedAt := time.Now()
+	job.Status = domain.VideoImportRunning
+	job.StartedAt = &startedAt
+	s.saveImportProgress(ctx, job)
+	slog.Info("Video import started", "job_id", job.ID, "source", job.Source, "source_id", job.SourceID)
+
+	var err error
+	switch job.Source {
+	case domain.VideoImportChannel:
+		var playlistID string
+		playlistID, err = s.youtube.GetChannelUploadsPlaylist(ctx, job.SourceID)
+		if err == nil {
+			err = s.importPlaylist(ctx, job, playlistID)
+		}
+	case domain.VideoImportPlaylist:
+		err = s.importPlaylist(ctx, job, job.SourceID)
+	case domain.VideoImportURLs:
+		job.Found = len(job.VideoIDs)
+		for start := 0; start < len(job.VideoIDs) && err == nil; start += infrastructure.YouTubeMaxResults {
+			end := min(start+infrastructure.YouTubeMaxResults, len(job.VideoIDs))
+			if err = s.importVideoBatch(ctx, job, job.VideoIDs[start:end]); err == nil {
+				s.saveImportProgress(ctx, job)
+			}
+		}
+	}
+
+	finishedAt := time.Now()
+	job.FinishedAt = &finishedAt
+	job.Status = domain.VideoImportCompleted
+	if err != nil {
+		job.Status = domain.VideoImportFailed
+		job.Error = err.Error()
+		if s.jobCtx.Err() != nil {
+			job.Error = "interrupted by server shutdown"
+		}
+	}
+	// The job context may have timed out or been cancelled: the final state must still be saved
+	s.saveImportProgress(context.Background(), job)
+
+	slog.Info("Video import finished", "job_id", job.ID, "status", job.Status,
+		"created", job.Created, "skipped", job.Skipped, "failed", job.Failed, "error", job.Error)
+}
+
+// importPlaylist pages through a playlist (50 IDs per page) and imports each page
+func (s *videoService) importPlaylist(ctx context.Context, job *domain.VideoImportJob, playlistID string) error {
+	pageToken := ""
+	for {
+		page, err := s.youtube.GetPlaylistPage(ctx, playlistID, pageToken)
+		if err != nil {
+			return err
+		}
+
+		job.Total = page.TotalResults
+		if job.MaxVideos > 0 {
+			job.Total = min(job.Total, job.MaxVideos)
+		}
+
+		ids := page.VideoIDs
+		if job.MaxVideos > 0 {
+			ids = ids[:min(len(ids), job.MaxVideos-job.Found)]
+		}
+		job.Found += len(ids)
+
+		if err := s.importVideoBatch(ctx, job, ids); err != nil {
+			return err
+		}
+		s.saveImportProgress(ctx, job)
+
+		if page.NextPageToken == "" || (job.MaxVideos > 0 && job.Found >= job.MaxVideos) {
+			return nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// importVideoBatch creates the videos of up to 50 YouTube IDs, skipping existing ones
+// Only API errors are returned (they stop the job); per-video failures are recorded on the job
+func (s *videoService) importVideoBatch(ctx context.Context, job *domain.VideoImportJob, ids []string) error {
+	existing, err := s.repo.GetExistingYoutubeIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, id := range ids {
+		if existing[id] {
+			job.Skipped++
+			continue
+		}
+		missing = append(missing, id)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	videos, err := s.fetchImportMetadata(ctx, missing)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range missing {
+		video, ok := videos[id]
+		if !ok {
+			job.AddError(fmt.Sprintf("%s: not available on YouTube (private, deleted or unknown)", id))
+			continue
+		}
+
+		created, err := s.repo.CreateVideoIfAbsent(ctx, video)
+		switch {
+		case err != nil:
+			job.AddError(fmt.Sprintf("%s: %v", id, err))
+		case created:
+			job.Created++
+		default:
+			job.Skipped++ // Created concurrently
+		}
+	}
+	return nil
+}
+
+// fetchImportMetadata fetches metadata of up to 50 videos in one API call, keyed by YouTube ID
+// Without an API key (URL imports only) videos get placeholder metadata, like CreateVideo
+func (s *videoService) fetchImportMetadata(ctx context.Context, ids []string) (map[string]*domain.Video, error) {
+	videos := make(map[string]*domain.Video, len(ids))
+
+	if s.youtube == nil {
+		for _, id := range ids {
+			info, err := helper.FetchYouTubeMetadata(id)
+			if err != nil {
+				return nil, err
+			}
+			videos[id] = &domain.Video{
+				YoutubeID:    id,
+				Title:        info.Title,
+				PublishedAt:  info.PublishedAt,
+				Duration:     info.Duration,
+				ViewCount:    info.ViewCount,
+				ThumbnailURL: info.ThumbnailURL,
+			}
+			helper.ApplyVideoMetadata(videos[id], info.VideoMetadataResponse)
+		}
+		return videos, nil
+	}
+
+	items, err := s.youtube.GetVideos(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	fetchedAt := time.Now()
+	for _, item := range items {
+		if !item.Available() {
+			continue // Reported as missing by importVideoBatch
+		}
+		videos[item.ID] = &domain.Video{
+			YoutubeID:    item.ID,
+			Title:        item.Title,
+			PublishedAt:  item.PublishedAt,
+			Duration:     helper.ParseDuration(item.Duration),
+			ViewCount:    item.ViewCount,
+			ThumbnailURL: item.ThumbnailURL,
+
+			MetadataRefreshedAt: &fetchedAt, // Fresh: skipped by the metadata refresh until stale
+		}
+		helper.ApplyVideoMetadata(videos[item.ID], helper.ToVideoMetadataFromYouTube(item))
+	}
+	return videos, nil
+}
+
+// saveImportProgress persists job progress (best effort: the job keeps running if it fails)
+func (s *videoService) saveImportProgress(ctx context.Context, job *domain.VideoImportJob) {
+	if err := s.repo.SaveImportJobProgress(ctx, job); err != nil {
+		slog.Warn("Failed to save video import progress", "job_id", job.ID, "error", err)
+	}
+}
+
+// toVideoImportJobResponse converts domain.VideoImportJob to dto.VideoImportJobResponse
+func toVideoImportJobResponse(job *domain.VideoImportJob) *dto.VideoImportJobResponse {
+	jobErrors := job.Errors
+	if jobErrors == nil {
+		jobErrors = []string{}
+	}
+	return &dto.VideoImportJobResponse{
+		ID:          job.ID.String(),
+		Source:      string(job.Source),
+		SourceID:    job.SourceID,
+		Status:      string(job.Status),
+		Total:       job.Total,
+		Found:       job.Found,
+		Processed:   job.Created + job.Skipped + job.Failed,
+		Created:     job.Created,
+		Skipped:     job.Skipped,
+		Failed:      job.Failed,
+		Errors:      jobErrors,
+		Error:       job.Error,
+		RequestedBy: job.RequestedBy.String(),
+		StartedAt:   job.StartedAt,
+		FinishedAt:  job.FinishedAt,
+		CreatedAt:   job.CreatedAt,
+	}
+}
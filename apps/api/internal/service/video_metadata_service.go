@@ -0,0 +1,83 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/helper"
+	"api/internal/infrastructure"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Quota: videos.list costs 1 unit per 50 videos, so one run costs at most
+// videoMetadataRefreshBatches units (240/day at the hourly default, of a 10,000/day quota)
+const (
+	videoMetadataRefreshBatches = 10
+	videoMetadataStaleAfter     = 7 * 24 * time.Hour
+)
+
+// RefreshVideoMetadata re-fetches title, view count, thumbnail, duration, publish date and channel/caption metadata from YouTube
+// Videos YouTube no longer returns (deleted, private, rejected) are marked unavailable, not deleted
+func (s *videoService) RefreshVideoMetadata(ctx context.Context) (int, error) {
+	if s.youtube == nil {
+		return 0, nil // Nothing to refresh from without YOUTUBE_API_KEY
+	}
+
+	videos, err := s.repo.GetVideosForMetadataRefresh(ctx, time.Now().Add(-videoMetadataStaleAfter),
+		videoMetadataRefreshBatches*infrastructure.YouTubeMaxResults)
+	if err != nil {
+		return 0, err
+	}
+
+	checked, unavailable := 0, 0
+	for start := 0; start < len(videos); start += infrastructure.YouTubeMaxResults {
+		batch := videos[start:min(start+infrastructure.YouTubeMaxResults, len(videos))]
+		ids := make([]string, len(batch))
+		for i, video := range batch {
+			ids[i] = video.YoutubeID
+		}
+
+		items, err := s.youtube.GetVideos(ctx, ids)
+		if err != nil {
+			return checked, err // Quota or network error: retry on the next run
+		}
+		byID := make(map[string]infrastructure.YouTubeVideo, len(items))
+		for _, item := range items {
+			byID[item.ID] = item
+		}
+
+		var refreshed []domain.Video
+		var unavailableIDs []uuid.UUID
+		for _, video := range batch {
+			item, ok := byID[video.YoutubeID]
+			if !ok || !item.Available() {
+				unavailableIDs = append(unavailableIDs, video.ID)
+				continue
+			}
+			fresh := domain.Video{
+				ID:           video.ID,
+				YoutubeID:    item.ID,
+				Title:        item.Title,
+				PublishedAt:  item.PublishedAt,
+				Duration:     helper.ParseDuration(item.Duration),
+				ViewCount:    item.ViewCount,
+				ThumbnailURL: item.ThumbnailURL,
+			}
+			helper.ApplyVideoMetadata(&fresh, helper.ToVideoMetadataFromYouTube(item))
+			refreshed = append(refreshed, fresh)
+		}
+
+		if err := s.repo.ApplyMetadataRefresh(ctx, refreshed, unavailableIDs, time.Now()); err != nil {
+			return checked, err
+		}
+		checked += len(batch)
+		unavailable += len(unavailableIDs)
+	}
+
+	if checked > 0 {
+		slog.Info("Video metadata refreshed", "checked", checked, "unavailable", unavailable)
+	}
+	return checked, nil
+}
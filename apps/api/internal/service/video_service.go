@@ -4,19 +4,31 @@ import (
 	"api/internal/domain"
 	"api/internal/dto"
 	"api/internal/helper"
+	"api/internal/infrastructure"
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
 
 type videoService struct {
-	repo domain.VideoRepository
+	repo    domain.VideoRepository
+	youtube *infrastructure.YouTubeClient // nil = no YOUTUBE_API_KEY (channel/playlist imports disabled)
+	jobCtx  context.Context               // Server lifetime: background imports stop when it is cancelled
 }
 
-func NewVideoService(repo domain.VideoRepository) domain.VideoService {
-	return &videoService{repo: repo}
+// NewVideoService creates the video service
+// jobCtx must be cancelled on server shutdown so running imports stop with it
+func NewVideoService(jobCtx context.Context, repo domain.VideoRepository, youtube *infrastructure.YouTubeClient) domain.VideoService {
+	return &videoService{repo: repo, youtube: youtube, jobCtx: jobCtx}
 }
 
 // GetModVideoList retrieves videos for mod dashboard with tags and optional has_transcript filter
@@ -55,33 +67,51 @@ func (s *videoService) GetModVideoList(page, pageSize int, searchQuery, tagIDsSt
 
 	// Convert to mod DTOs with tags
 	result := make([]dto.ModVideoResponse, len(videos))
-	for i, video := range videos {
-		tags := make([]dto.TagResponse, len(video.CanonicalTags))
-		for j, tag := range video.CanonicalTags {
-			tags[j] = dto.TagResponse{
-				ID:   tag.ID.String(),
-				Name: tag.DisplayName,
-			}
-		}
+	for i := range videos {
+		result[i] = toModVideoResponse(&videos[i], reviewCounts[videos[i].ID])
+	}
 
-		result[i] = dto.ModVideoResponse{
-			ID:            video.ID.String(),
-			YoutubeID:     video.YoutubeID,
-			Title:         video.Title,
-			Description:   "", // TODO: Add description field to Video model if needed
-			ThumbnailURL:  video.ThumbnailURL,
-			Duration:      video.Duration,
-			PublishedAt:   video.PublishedAt.Format("2006-01-02"),
-			ViewCount:     video.ViewCount,
-			HasTranscript: video.HasTranscript,
-			ReviewCount:   reviewCounts[video.ID],
-			Tags:          tags,
-			CreatedAt:     video.CreatedAt.Format("2006-01-02T15:04:05Z"),
-			UpdatedAt:     video.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	return result, total, nil
+}
+
+// toModVideoResponse converts domain.Video (with CanonicalTags loaded) to dto.ModVideoResponse
+func toModVideoResponse(video *domain.Video, reviewCount int) dto.ModVideoResponse {
+	tags := make([]dto.TagResponse, len(video.CanonicalTags))
+	for j, tag := range video.CanonicalTags {
+		tags[j] = dto.TagResponse{
+			ID:         tag.ID.String(),
+			Name:       tag.DisplayName,
+			VideoCount: tag.VideoCount,
+			AliasCount: tag.AliasCount,
 		}
 	}
 
-	return result, total, nil
+	manualFields := video.ManualFields
+	if manualFields == nil {
+		manualFields = []string{}
+	}
+
+	return dto.ModVideoResponse{
+		ID:            video.ID.String(),
+		YoutubeID:     video.YoutubeID,
+		Title:         video.Title,
+		ThumbnailURL:  video.ThumbnailURL,
+		Duration:      video.Duration,
+		PublishedAt:   video.PublishedAt.Format("2006-01-02"),
+		ViewCount:     video.ViewCount,
+		HasTranscript: video.HasTranscript,
+		ReviewCount:   reviewCount,
+		Tags:          tags,
+		CreatedAt:     video.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:     video.UpdatedAt.UTC().Format(time.RFC3339Nano), // Full precision: echoed back by PATCH for conflict detection
+
+		VideoMetadataResponse: helper.ToVideoMetadataResponse(video),
+		ManualFields:          manualFields,
+
+		Availability:        string(video.Availability),
+		UnavailableSince:    video.UnavailableSince,
+		MetadataRefreshedAt: video.MetadataRefreshedAt,
+	}
 }
 
 // GetVideoList retrieves paginated video list
@@ -134,7 +164,8 @@ func (s *videoService) GetVideoList(req dto.ListVideoRequest) (*dto.VideoListRes
 }
 
 // GetVideoDetail retrieves single video with full details
-func (s *videoService) GetVideoDetail(id string) (*dto.VideoDetailResponse, error) {
+// Tag names are localized for locales (first match wins, then the default name)
+func (s *videoService) GetVideoDetail(id string, locales []string) (*dto.VideoDetailResponse, error) {
 	videoUUID, err := uuid.Parse(id)
 	if err != nil {
 		return nil, fmt.Errorf("invalid video id: %w", err)
@@ -152,7 +183,19 @@ func (s *videoService) GetVideoDetail(id string) (*dto.VideoDetailResponse, erro
 		reviewCount = reviewCounts[videoUUID]
 	}
 
-	return helper.ToVideoDetailResponse(video, reviewCount), nil
+	// Localized tag names (optional - default names on failure)
+	var displayNames map[uuid.UUID]map[string]string
+	if len(locales) > 0 && len(video.CanonicalTags) > 0 {
+		tagIDs := make([]uuid.UUID, len(video.CanonicalTags))
+		for i, tag := range video.CanonicalTags {
+			tagIDs[i] = tag.ID
+		}
+		if displayNames, err = s.repo.GetTagDisplayNames(tagIDs); err != nil {
+			slog.Warn("Failed to get tag display names", "error", err)
+		}
+	}
+
+	return helper.ToVideoDetailResponse(video, reviewCount, displayNames, locales), nil
 }
 
 // GetVideoTranscript retrieves transcript segments for a video
@@ -264,20 +307,29 @@ func (s *videoService) SearchTags(req dto.TagSearchRequest) (*dto.TagSearchRespo
 
 // CreateVideo creates a new video by fetching metadata from YouTube
 func (s *videoService) CreateVideo(req dto.CreateVideoRequest) (*dto.VideoCreateResponse, error) {
-	// Check if video already exists
-	if existing, _ := s.repo.GetVideoByYoutubeID(req.YoutubeID); existing != nil {
-		return nil, fmt.Errorf("video with YouTube ID '%s' already exists", req.YoutubeID)
+	youtubeID, ok := helper.ParseYouTubeID(req.YoutubeID)
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", domain.ErrInvalidYouTubeInput, req.YoutubeID)
+	}
+
+	// Check live and soft-deleted videos (the youtube_id unique index covers both)
+	existing, err := s.repo.FindVideoByYoutubeIDWithDeleted(youtubeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing video: %w", err)
+	}
+	if existing != nil {
+		return nil, &domain.VideoExistsError{Video: existing, Deleted: existing.DeletedAt.Valid}
 	}
 
 	// Fetch metadata from YouTube
-	youtubeInfo, err := helper.FetchYouTubeMetadata(req.YoutubeID)
+	youtubeInfo, err := helper.FetchYouTubeMetadata(youtubeID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch YouTube metadata: %w", err)
 	}
 
 	// Create video record
 	video := &domain.Video{
-		YoutubeID:     req.YoutubeID,
+		YoutubeID:     youtubeID,
 		Title:         youtubeInfo.Title,
 		PublishedAt:   youtubeInfo.PublishedAt,
 		Duration:      youtubeInfo.Duration,
@@ -285,9 +337,18 @@ func (s *videoService) CreateVideo(req dto.CreateVideoRequest) (*dto.VideoCreate
 		ThumbnailURL:  youtubeInfo.ThumbnailURL,
 		HasTranscript: false,
 	}
+	helper.ApplyVideoMetadata(video, youtubeInfo.VideoMetadataResponse)
 
-	if err := s.repo.Create(video); err != nil {
-		return nil, fmt.Errorf("failed to create video: %w", err)
+	created, err := s.repo.CreateVideoIfAbsent(context.Background(), video)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		// Created concurrently since the check above
+		if existing, _ := s.repo.FindVideoByYoutubeIDWithDeleted(youtubeID); existing != nil {
+			return nil, &domain.VideoExistsError{Video: existing, Deleted: existing.DeletedAt.Valid}
+		}
+		return nil, fmt.Errorf("%w: '%s'", domain.ErrVideoExists, youtubeID)
 	}
 
 	return &dto.VideoCreateResponse{
@@ -300,15 +361,23 @@ func (s *videoService) CreateVideo(req dto.CreateVideoRequest) (*dto.VideoCreate
 		ThumbnailURL:  video.ThumbnailURL,
 		HasTranscript: video.HasTranscript,
 		CreatedAt:     video.CreatedAt,
+
+		VideoMetadataResponse: helper.ToVideoMetadataResponse(video),
 	}, nil
 }
 
 // DeleteVideo soft deletes a video
 // PreviewYouTubeVideo fetches YouTube metadata without saving to database
-func (s *videoService) PreviewYouTubeVideo(youtubeID string) (*dto.VideoCreateResponse, error) {
-	// Validate YouTube ID format
-	if youtubeID == "" || len(youtubeID) != 11 {
-		return nil, fmt.Errorf("invalid YouTube ID format")
+// Accepts a video ID or URL; flags videos that are already stored (live or soft-deleted)
+func (s *videoService) PreviewYouTubeVideo(input string) (*dto.VideoCreateResponse, error) {
+	youtubeID, ok := helper.ParseYouTubeID(input)
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", domain.ErrInvalidYouTubeInput, input)
+	}
+
+	existing, err := s.repo.FindVideoByYoutubeIDWithDeleted(youtubeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing video: %w", err)
 	}
 
 	// Fetch metadata from YouTube API
@@ -318,7 +387,7 @@ func (s *videoService) PreviewYouTubeVideo(youtubeID string) (*dto.VideoCreateRe
 	}
 
 	// Return preview response (no database save)
-	return &dto.VideoCreateResponse{
+	response := &dto.VideoCreateResponse{
 		ID:           "", // Empty since not saved yet
 		YoutubeID:    metadata.ID,
 		Title:        metadata.Title,
@@ -326,6 +395,47 @@ func (s *videoService) PreviewYouTubeVideo(youtubeID string) (*dto.VideoCreateRe
 		Duration:     metadata.Duration,
 		ViewCount:    metadata.ViewCount,
 		PublishedAt:  metadata.PublishedAt,
+
+		VideoMetadataResponse: metadata.VideoMetadataResponse,
+	}
+	if existing != nil {
+		response.ExistingVideoID = existing.ID.String()
+		response.ExistingDeleted = existing.DeletedAt.Valid
+	}
+	return response, nil
+}
+
+// RestoreVideo undoes the soft delete of a video (tags and transcript are kept on delete)
+func (s *videoService) RestoreVideo(id string) (*dto.VideoCreateResponse, error) {
+	videoUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid video ID", domain.ErrInvalidRequest)
+	}
+
+	if err := s.repo.Restore(videoUUID); err != nil {
+		if errors.Is(err, domain.ErrVideoNotFound) {
+			return nil, fmt.Errorf("%w: no deleted video with ID %s", domain.ErrVideoNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to restore video: %w", err)
+	}
+
+	video, err := s.repo.GetVideoByID(videoUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get restored video: %w", err)
+	}
+
+	return &dto.VideoCreateResponse{
+		ID:            video.ID.String(),
+		YoutubeID:     video.YoutubeID,
+		Title:         video.Title,
+		PublishedAt:   video.PublishedAt,
+		Duration:      video.Duration,
+		ViewCount:     video.ViewCount,
+		ThumbnailURL:  video.ThumbnailURL,
+		HasTranscript: video.HasTranscript,
+		CreatedAt:     video.CreatedAt,
+
+		VideoMetadataResponse: helper.ToVideoMetadataResponse(video),
 	}, nil
 }
 
@@ -347,6 +457,138 @@ func (s *videoService) DeleteVideo(id string) error {
 	return nil
 }
 
+// Limits of mod-edited video fields
+const (
+	maxVideoTitleLength       = 500
+	maxVideoDescriptionLength = 5000 // YouTube's own limit
+	maxVideoThumbnailLength   = 500
+	maxVideoDuration          = 24 * 60 * 60
+)
+
+// UpdateVideo applies a mod edit of video metadata with optimistic concurrency on updated_at
+// Edited fields are locked against the scheduled YouTube refresh until unlocked
+func (s *videoService) UpdateVideo(ctx context.Context, id string, req dto.UpdateVideoRequest) (*dto.ModVideoResponse, error) {
+	videoUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid video ID", domain.ErrInvalidRequest)
+	}
+
+	updates, edited, err := validateVideoUpdate(req)
+	if err != nil {
+		return nil, err
+	}
+
+	video, err := s.repo.GetVideoByID(videoUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrVideoNotFound, err)
+	}
+
+	// Lock edited fields, release unlocked ones
+	manualFields := make([]string, 0, len(video.ManualFields)+len(edited))
+	for _, field := range append(slices.Clone(video.ManualFields), edited...) {
+		if !slices.Contains(manualFields, field) && !slices.Contains(req.UnlockFields, field) {
+			manualFields = append(manualFields, field)
+		}
+	}
+	if err := s.repo.UpdateVideoMetadata(ctx, videoUUID, req.UpdatedAt, updates, manualFields); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.GetVideoByID(videoUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated video: %w", err)
+	}
+
+	reviewCounts, err := s.repo.GetReviewCountsForVideos([]uuid.UUID{videoUUID})
+	if err != nil {
+		slog.Warn("Failed to get review counts", "error", err)
+	}
+
+	slog.Info("Video metadata edited", "video_id", videoUUID, "fields", edited, "unlocked", req.UnlockFields)
+	response := toModVideoResponse(updated, reviewCounts[videoUUID])
+	return &response, nil
+}
+
+// validateVideoUpdate checks every field set in the request
+// Returns the column updates and the edited fields, or ErrInvalidRequest listing each invalid field
+func validateVideoUpdate(req dto.UpdateVideoRequest) (map[string]interface{}, []string, error) {
+	updates := make(map[string]interface{})
+	var edited, problems []string
+
+	set := func(field string, value interface{}) {
+		updates[field] = value
+		edited = append(edited, field)
+	}
+
+	if req.Title != nil {
+		title := strings.TrimSpace(*req.Title)
+		switch {
+		case title == "":
+			problems = append(problems, "title: must not be empty")
+		case utf8.RuneCountInString(title) > maxVideoTitleLength:
+			problems = append(problems, fmt.Sprintf("title: at most %d characters", maxVideoTitleLength))
+		default:
+			set(domain.VideoFieldTitle, title)
+		}
+	}
+
+	if req.Description != nil {
+		description := strings.TrimSpace(*req.Description)
+		if utf8.RuneCountInString(description) > maxVideoDescriptionLength {
+			problems = append(problems, fmt.Sprintf("description: at most %d characters", maxVideoDescriptionLength))
+		} else {
+			set(domain.VideoFieldDescription, description)
+		}
+	}
+
+	if req.ThumbnailURL != nil {
+		thumbnailURL := strings.TrimSpace(*req.ThumbnailURL)
+		u, err := url.Parse(thumbnailURL)
+		switch {
+		case err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "":
+			problems = append(problems, "thumbnail_url: must be an http(s) URL")
+		case len(thumbnailURL) > maxVideoThumbnailLength:
+			problems = append(problems, fmt.Sprintf("thumbnail_url: at most %d characters", maxVideoThumbnailLength))
+		default:
+			set(domain.VideoFieldThumbnailURL, thumbnailURL)
+		}
+	}
+
+	if req.Duration != nil {
+		if *req.Duration < 1 || *req.Duration > maxVideoDuration {
+			problems = append(problems, fmt.Sprintf("duration: must be between 1 and %d seconds", maxVideoDuration))
+		} else {
+			set(domain.VideoFieldDuration, *req.Duration)
+		}
+	}
+
+	if req.PublishedAt != nil {
+		publishedAt, err := time.Parse("2006-01-02", *req.PublishedAt)
+		switch {
+		case err != nil:
+			problems = append(problems, "published_at: must be a date (YYYY-MM-DD)")
+		case publishedAt.After(time.Now()):
+			problems = append(problems, "published_at: must not be in the future")
+		default:
+			set(domain.VideoFieldPublishedAt, publishedAt)
+		}
+	}
+
+	for _, field := range req.UnlockFields {
+		if slices.Contains(edited, field) {
+			problems = append(problems, fmt.Sprintf("unlock_fields: '%s' is also being edited", field))
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, nil, fmt.Errorf("%w: %s", domain.ErrInvalidRequest, strings.Join(problems, "; "))
+	}
+	if len(edited) == 0 && len(req.UnlockFields) == 0 {
+		return nil, nil, fmt.Errorf("%w: no fields to update", domain.ErrInvalidRequest)
+	}
+	return updates, edited, nil
+}
+
 // SearchVideos searches videos by title
 func (s *videoService) SearchVideos(query string, page, limit int) (*dto.VideoListResponse, error) {
 	if page < 1 {
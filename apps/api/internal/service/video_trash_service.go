@@ -0,0 +1,100 @@
+package service
+
+import (
+	"api/internal/domain"
+	"api/internal/dto"
+	"api/internal/helper"
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// videoPurgeBatchSize bounds one purge transaction of the retention job
+const videoPurgeBatchSize = 100
+
+// ListTrashedVideos returns soft-deleted videos, most recently deleted first
+func (s *videoService) ListTrashedVideos(ctx context.Context, page, limit int) (*dto.TrashedVideoListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 20
+	}
+
+	videos, total, err := s.repo.ListDeletedVideos(ctx, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.TrashedVideoResponse, len(videos))
+	for i := range videos {
+		items[i] = dto.TrashedVideoResponse{
+			VideoCardResponse: helper.ToVideoCardResponse(&videos[i]),
+			DeletedAt:         videos[i].DeletedAt.Time,
+		}
+	}
+
+	return &dto.TrashedVideoListResponse{
+		Data: items,
+		Pagination: dto.PaginationMetadata{
+			Page:       page,
+			Limit:      limit,
+			TotalItems: total,
+			TotalPages: int(math.Ceil(float64(total) / float64(limit))),
+		},
+	}, nil
+}
+
+// PurgeVideo permanently deletes a video from the trash (only soft-deleted videos can be purged)
+func (s *videoService) PurgeVideo(ctx context.Context, id string) error {
+	videoUUID, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("%w: invalid video ID", domain.ErrInvalidRequest)
+	}
+
+	purged, err := s.repo.PurgeVideos(ctx, []uuid.UUID{videoUUID})
+	if err != nil {
+		return err
+	}
+	if purged == 0 {
+		return fmt.Errorf("%w: no deleted video with ID %s (delete it first)", domain.ErrVideoNotFound, id)
+	}
+
+	slog.Info("Video purged", "video_id", videoUUID)
+	return nil
+}
+
+// PurgeExpiredVideos permanently deletes videos that have been in the trash longer than retention
+func (s *videoService) PurgeExpiredVideos(ctx context.Context, retention time.Duration) (int, error) {
+	before := time.Now().Add(-retention)
+
+	total := 0
+	for {
+		ids, err := s.repo.GetVideoIDsDeletedBefore(ctx, before, videoPurgeBatchSize)
+		if err != nil {
+			return total, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		purged, err := s.repo.PurgeVideos(ctx, ids)
+		if err != nil {
+			return total, err
+		}
+		total += int(purged)
+
+		if len(ids) < videoPurgeBatchSize || purged == 0 {
+			break
+		}
+	}
+
+	if total > 0 {
+		slog.Info("Expired videos purged from trash", "count", total, "retention", retention)
+	}
+	return total, nil
+}
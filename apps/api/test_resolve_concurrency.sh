@@ -0,0 +1,59 @@
+#!/usr/bin/env bash
+# Test concurrent ResolveTag: N parallel resolutions of the same new term must return the same canonical ID
+# Usage: TOKEN=<mod access token> ./test_resolve_concurrency.sh [parallel_requests] [term]
+
+API_BASE="${API_BASE:-http://localhost:8080}"
+N="${1:-20}"
+TERM_NAME="${2:-Concurrency Test $(date +%s)}"
+
+GREEN='\033[0;32m'
+YELLOW='\033[1;33m'
+RED='\033[0;31m'
+NC='\033[0m' # No Color
+
+if [ -z "$TOKEN" ]; then
+  echo -e "${RED}❌ TOKEN is required (mod or admin access token)${NC}"
+  exit 1
+fi
+
+echo "🧪 Testing concurrent tag resolution"
+echo "========================================"
+echo -e "${YELLOW}Firing $N parallel POST /api/v2/mod/tags/resolve for '$TERM_NAME'${NC}"
+echo ""
+
+OUT_DIR=$(mktemp -d)
+trap 'rm -rf "$OUT_DIR"' EXIT
+
+for i in $(seq 1 "$N"); do
+  curl -s -o "$OUT_DIR/$i.json" -w "%{http_code}" -X POST "$API_BASE/api/v2/mod/tags/resolve" \
+    -H "Content-Type: application/json" \
+    -H "Authorization: Bearer $TOKEN" \
+    -d "{\"name\": \"$TERM_NAME\"}" > "$OUT_DIR/$i.status" &
+done
+wait
+
+FAILED=0
+for i in $(seq 1 "$N"); do
+  STATUS=$(cat "$OUT_DIR/$i.status")
+  if [ "$STATUS" != "200" ] && [ "$STATUS" != "201" ]; then
+    echo -e "${RED}Request $i: HTTP $STATUS${NC} $(cat "$OUT_DIR/$i.json")"
+    FAILED=$((FAILED + 1))
+  fi
+done
+
+IDS=$(jq -r '.data.id // empty' "$OUT_DIR"/*.json | sort -u)
+ID_COUNT=$(echo "$IDS" | grep -c .)
+CREATED=$(grep -l '^201$' "$OUT_DIR"/*.status | wc -l | tr -d ' ')
+
+echo "Distinct canonical IDs: $ID_COUNT"
+echo "$IDS"
+echo "Requests that created the tag (201): $CREATED"
+echo ""
+
+if [ "$FAILED" -eq 0 ] && [ "$ID_COUNT" -eq 1 ] && [ "$CREATED" -le 1 ]; then
+  echo -e "${GREEN}✅ All $N resolutions returned the same canonical tag${NC}"
+  exit 0
+fi
+
+echo -e "${RED}❌ Expected $N successes with 1 canonical ID (got $FAILED failures, $ID_COUNT IDs, $CREATED creations)${NC}"
+exit 1